@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/zephinzer/semver/semver"
+)
+
+// buildNumberVariables lists the environment variables checked in order
+// for the build number of the CI service
+var buildNumberVariables = []string{
+	"GOSEMVER_BUILD_NUMBER",
+	"BUILD_NUMBER",
+	"GITHUB_RUN_NUMBER",
+	"CI_PIPELINE_IID",
+	"CIRCLE_BUILD_NUM",
+	"TRAVIS_BUILD_NUMBER",
+	"BUILDKITE_BUILD_NUMBER",
+	"DRONE_BUILD_NUMBER",
+	"BUILD_BUILDID",
+}
+
+// buildData is the data made available to the --build template
+type buildData struct {
+	// since is the version the commits are counted from
+	since semver.ISemver
+	now   time.Time
+}
+
+// SHA returns the abbreviated SHA of HEAD
+func (data buildData) SHA() (string, error) {
+	return gitShortSHA("HEAD")
+}
+
+// Commits returns the number of commits made since the tag of the version,
+// or all commits if it is not tagged
+func (data buildData) Commits() (int, error) {
+	tag := "refs/tags/" + data.since.String()
+	if _, err := gitRevParse(tag); err != nil {
+		return gitRevListCount("HEAD")
+	}
+	return gitRevListCount(tag + "..HEAD")
+}
+
+// Timestamp returns the current UTC time as YYYYMMDDhhmmss
+func (data buildData) Timestamp() string {
+	return data.now.UTC().Format("20060102150405")
+}
+
+// BuildNumber returns the build number of the CI service from the first
+// of the buildNumberVariables which is set
+func (data buildData) BuildNumber() (string, error) {
+	for _, variable := range buildNumberVariables {
+		if buildNumber := os.Getenv(variable); len(buildNumber) > 0 {
+			return buildNumber, nil
+		}
+	}
+	return "", fmt.Errorf("no build number found in any of %s", strings.Join(buildNumberVariables, ", "))
+}
+
+// buildFunctions are the functions available to --build templates
+var buildFunctions = template.FuncMap{
+	"env": os.Getenv,
+}
+
+// renderBuild renders the :build template with the commits counted since
+// the version :since
+func renderBuild(build string, since semver.ISemver) (string, error) {
+	parsedTemplate, err := template.New("build").Funcs(buildFunctions).Parse(build)
+	if err != nil {
+		return "", fmt.Errorf("invalid build '%s' specified: %s", build, err)
+	}
+	var output bytes.Buffer
+	if err := parsedTemplate.Execute(&output, buildData{since, time.Now()}); err != nil {
+		return "", fmt.Errorf("invalid build '%s' specified: %s", build, err)
+	}
+	return output.String(), nil
+}
+
+// stampBuild sets the build metadata of the :version to the rendered
+// :build template, with the commits counted since the version :since
+func stampBuild(version semver.ISemver, build string, since semver.ISemver) error {
+	rendered, err := renderBuild(build, since)
+	if err != nil {
+		return err
+	}
+	return version.SetBuild(rendered)
+}
+
+// stampDevelopment makes the :version the development version of HEAD,
+// counting the commits since the version :since, eg. 1.4.1-dev.7+g9f2c1e
+// for the 7th commit after 1.4.0, with '.dirty' appended to the build
+// metadata if the worktree has changes. the :version is left as is if HEAD
+// is the tagged version and the worktree is clean
+func stampDevelopment(version semver.ISemver, since semver.ISemver) error {
+	data := buildData{since: since}
+	commits, err := data.Commits()
+	if err != nil {
+		return err
+	}
+	dirty, err := gitIsDirty()
+	if err != nil {
+		return err
+	}
+	if _, err := gitRevParse("refs/tags/" + since.String()); err == nil && commits == 0 && !dirty {
+		return nil
+	}
+	sha, err := data.SHA()
+	if err != nil {
+		return err
+	}
+	build := "g" + sha
+	if dirty {
+		build += ".dirty"
+	}
+	version.Develop(commits)
+	return version.SetBuild(build)
+}
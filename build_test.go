@@ -0,0 +1,108 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/zephinzer/semver/semver"
+)
+
+type BuildTestSuite struct {
+	GitRepositoryTestSuite
+	environment map[string]string
+}
+
+func TestBuild(t *testing.T) {
+	suite.Run(t, new(BuildTestSuite))
+}
+
+func (s *BuildTestSuite) SetupTest() {
+	s.GitRepositoryTestSuite.SetupTest()
+	s.environment = map[string]string{}
+	for _, variable := range buildNumberVariables {
+		s.environment[variable] = os.Getenv(variable)
+		os.Unsetenv(variable)
+	}
+}
+
+func (s *BuildTestSuite) TearDownTest() {
+	for variable, value := range s.environment {
+		os.Setenv(variable, value)
+	}
+	s.GitRepositoryTestSuite.TearDownTest()
+}
+
+func (s *BuildTestSuite) Test_renderBuild() {
+	s.commit("initial commit")
+	s.git("tag", "v1.0.0")
+	s.commit("second commit")
+	s.commit("third commit")
+	os.Setenv("BUILD_NUMBER", "42")
+	sha := s.git("rev-parse", "--short", "HEAD")
+	build, err := renderBuild("build.{{.BuildNumber}}.sha.{{.SHA}}.commits.{{.Commits}}", semver.MustParse("v1.0.0", "v"))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "build.42.sha."+sha+".commits.2", build)
+}
+
+func (s *BuildTestSuite) Test_renderBuild_untagged() {
+	s.commit("initial commit")
+	s.commit("second commit")
+	build, err := renderBuild("{{.Commits}}", semver.MustParse("0.0.0"))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "2", build)
+}
+
+func (s *BuildTestSuite) Test_renderBuild_timestamp() {
+	build, err := renderBuild("{{.Timestamp}}", semver.MustParse("0.0.0"))
+	assert.Nil(s.T(), err)
+	assert.Regexp(s.T(), `^\d{14}$`, build)
+}
+
+func (s *BuildTestSuite) Test_renderBuild_env() {
+	os.Setenv("GOSEMVER_BUILD_NUMBER", "7")
+	build, err := renderBuild("ci.{{env \"GOSEMVER_BUILD_NUMBER\"}}", semver.MustParse("0.0.0"))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "ci.7", build)
+}
+
+func (s *BuildTestSuite) Test_renderBuild_noBuildNumber() {
+	_, err := renderBuild("{{.BuildNumber}}", semver.MustParse("0.0.0"))
+	assert.Contains(s.T(), err.Error(), "no build number found in any of GOSEMVER_BUILD_NUMBER, BUILD_NUMBER")
+}
+
+func (s *BuildTestSuite) Test_stampBuild() {
+	version := semver.MustParse("1.2.3")
+	assert.Nil(s.T(), stampBuild(version, "build.5", version))
+	assert.Equal(s.T(), "1.2.3+build.5", version.String())
+	err := stampBuild(version, "build_5", version)
+	assert.Contains(s.T(), err.Error(), "invalid build metadata 'build_5'")
+}
+
+func (s *BuildTestSuite) Test_stampDevelopment() {
+	s.commit("initial commit")
+	s.git("tag", "v1.4.0")
+	for index := 0; index < 7; index++ {
+		s.commit("change")
+	}
+	sha := s.git("rev-parse", "--short", "HEAD")
+	version := semver.MustParse("v1.4.0", "v")
+	assert.Nil(s.T(), stampDevelopment(version, semver.MustParse("v1.4.0", "v")))
+	assert.Equal(s.T(), "v1.4.1-dev.7+g"+sha, version.String())
+}
+
+func (s *BuildTestSuite) Test_stampDevelopment_dirty() {
+	assert.Nil(s.T(), ioutil.WriteFile("VERSION", []byte("1.4.0\n"), 0644))
+	s.git("add", "VERSION")
+	s.commit("initial commit")
+	s.git("tag", "1.4.0")
+	sha := s.git("rev-parse", "--short", "HEAD")
+	version := semver.MustParse("1.4.0")
+	assert.Nil(s.T(), stampDevelopment(version, semver.MustParse("1.4.0")))
+	assert.Equal(s.T(), "1.4.0", version.String())
+	assert.Nil(s.T(), ioutil.WriteFile("VERSION", []byte("1.4.1\n"), 0644))
+	assert.Nil(s.T(), stampDevelopment(version, semver.MustParse("1.4.0")))
+	assert.Equal(s.T(), "1.4.1-dev.0+g"+sha+".dirty", version.String())
+}
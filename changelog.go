@@ -0,0 +1,153 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/zephinzer/semver/semver"
+)
+
+// changelogHeader is written at the start of newly created changelogs
+const changelogHeader = `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.0.0/),
+and this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).
+`
+
+// changelogSection groups the Conventional Commit types rendered under a
+// Keep a Changelog section
+type changelogSection struct {
+	title string
+	types []string
+}
+
+// changelogSections defines the order of sections in a changelog entry and
+// the commit types listed in each of them. commits of other types are
+// considered not notable and are left out
+var changelogSections = []changelogSection{
+	{"Added", []string{"feat"}},
+	{"Changed", []string{"perf", "refactor"}},
+	{"Removed", []string{"revert"}},
+	{"Fixed", []string{"fix"}},
+	{"Security", []string{"security"}},
+}
+
+// renderChangelog renders a Keep a Changelog entry for the :version released
+// on :date from the commit :messages. an empty :version renders an entry for
+// unreleased changes
+func renderChangelog(version string, date string, messages []string) string {
+	var commits []conventionalCommit
+	for _, message := range messages {
+		if commit, ok := parseConventionalCommit(message); ok {
+			commits = append(commits, commit)
+		}
+	}
+	var entry strings.Builder
+	if len(version) == 0 {
+		entry.WriteString("## [Unreleased]\n")
+	} else {
+		entry.WriteString(fmt.Sprintf("## [%s] - %s\n", version, date))
+	}
+	notable := false
+	var breakingChanges []string
+	for _, commit := range commits {
+		if commit.Breaking {
+			breakingChanges = append(breakingChanges, renderChangelogItem(commit))
+		}
+	}
+	if len(breakingChanges) > 0 {
+		notable = true
+		entry.WriteString("\n### Breaking Changes\n")
+		entry.WriteString(strings.Join(breakingChanges, ""))
+	}
+	for _, section := range changelogSections {
+		var items []string
+		for _, commit := range commits {
+			if sliceContainsString(section.types, commit.Type) {
+				items = append(items, renderChangelogItem(commit))
+			}
+		}
+		if len(items) > 0 {
+			notable = true
+			entry.WriteString(fmt.Sprintf("\n### %s\n", section.title))
+			entry.WriteString(strings.Join(items, ""))
+		}
+	}
+	if !notable {
+		entry.WriteString("\nNo notable changes.\n")
+	}
+	return entry.String()
+}
+
+// renderChangelogItem renders a single :commit as a changelog list item
+func renderChangelogItem(commit conventionalCommit) string {
+	if len(commit.Scope) > 0 {
+		return fmt.Sprintf("- **%s:** %s\n", commit.Scope, commit.Description)
+	}
+	return fmt.Sprintf("- %s\n", commit.Description)
+}
+
+// prependChangelog inserts the changelog :entry into the file at :path
+// before any existing entries, creating the file if it does not exist
+func prependChangelog(path string, entry string) error {
+	_, updated, err := prependedChangelog(path, entry)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(updated), 0644)
+}
+
+// prependedChangelog returns the contents of the changelog at :path before
+// and after inserting the changelog :entry
+func prependedChangelog(path string, entry string) (string, string, error) {
+	existing, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", "", err
+	}
+	contents := string(existing)
+	if len(strings.TrimSpace(contents)) == 0 {
+		contents = changelogHeader
+	}
+	var updated string
+	if index := strings.Index(contents, "\n## "); index >= 0 {
+		updated = contents[:index+1] + entry + "\n" + contents[index+1:]
+	} else if strings.HasPrefix(contents, "## ") {
+		updated = entry + "\n" + contents
+	} else {
+		updated = strings.TrimRight(contents, "\n") + "\n\n" + entry
+	}
+	return string(existing), updated, nil
+}
+
+// changelogRange returns the revision range of commits from :from up to
+// :to. if :from is not specified, it defaults to the highest semver tag
+// which is lower than :to, or all commits up to :to if there is none
+func changelogRange(from string, to string, prefix string) (string, error) {
+	if len(from) > 0 {
+		return from + ".." + to, nil
+	}
+	loader := GitLoader{}
+	tags, err := loader.getAllTags(prefix)
+	if err != nil {
+		return "", err
+	}
+	upper, _ := semver.Parse(to, prefix)
+	var lower semver.ISemver
+	for _, tag := range filterSemverLike(tags, prefix) {
+		version := semver.MustParse(tag, prefix)
+		if upper != nil && semver.Compare(version, upper) >= 0 {
+			continue
+		}
+		if lower == nil || semver.Compare(version, lower) > 0 {
+			lower = version
+		}
+	}
+	if lower == nil {
+		return to, nil
+	}
+	return lower.String() + ".." + to, nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChangelogTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestChangelog(t *testing.T) {
+	suite.Run(t, new(ChangelogTestSuite))
+}
+
+func (s *ChangelogTestSuite) Test_renderChangelog() {
+	entry := renderChangelog("1.1.0", "2019-03-18", []string{
+		"feat(cli): add changelog command",
+		"fix: handle empty repositories",
+		"chore: tidy up",
+		"not a conventional commit",
+		"refactor!: rename flags\n\nBREAKING CHANGE: flags are namespaced",
+		"feat: add auto bumps",
+	})
+	assert.Equal(s.T(), `## [1.1.0] - 2019-03-18
+
+### Breaking Changes
+- rename flags
+
+### Added
+- **cli:** add changelog command
+- add auto bumps
+
+### Changed
+- rename flags
+
+### Fixed
+- handle empty repositories
+`, entry)
+}
+
+func (s *ChangelogTestSuite) Test_renderChangelog_unreleased() {
+	assert.Equal(s.T(), "## [Unreleased]\n\nNo notable changes.\n", renderChangelog("", "", []string{"chore: tidy up"}))
+}
+
+func (s *ChangelogTestSuite) Test_prependChangelog_newFile() {
+	assert.Nil(s.T(), prependChangelog("CHANGELOG.md", "## [1.0.0] - 2019-03-18\n"))
+	contents, _ := ioutil.ReadFile("CHANGELOG.md")
+	assert.Equal(s.T(), changelogHeader+"\n## [1.0.0] - 2019-03-18\n", string(contents))
+}
+
+func (s *ChangelogTestSuite) Test_prependChangelog_existingEntries() {
+	ioutil.WriteFile("CHANGELOG.md", []byte("# Changelog\n\n## [1.0.0] - 2019-03-18\n\n### Added\n- something\n"), 0644)
+	assert.Nil(s.T(), prependChangelog("CHANGELOG.md", "## [1.1.0] - 2019-03-19\n\n### Fixed\n- something else\n"))
+	contents, _ := ioutil.ReadFile("CHANGELOG.md")
+	assert.Equal(s.T(), "# Changelog\n\n## [1.1.0] - 2019-03-19\n\n### Fixed\n- something else\n\n## [1.0.0] - 2019-03-18\n\n### Added\n- something\n", string(contents))
+}
+
+func (s *ChangelogTestSuite) Test_changelogRange() {
+	s.commit("feat: first")
+	s.git("tag", "v1.0.0")
+	s.commit("feat: second")
+	s.git("tag", "v1.1.0")
+	s.commit("fix: third")
+	revisionRange, err := changelogRange("", "HEAD", "v")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "v1.1.0..HEAD", revisionRange)
+	revisionRange, err = changelogRange("", "v1.1.0", "v")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "v1.0.0..v1.1.0", revisionRange)
+	revisionRange, err = changelogRange("", "v1.0.0", "v")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "v1.0.0", revisionRange)
+	revisionRange, err = changelogRange("abc123", "HEAD", "v")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "abc123..HEAD", revisionRange)
+}
@@ -9,43 +9,89 @@ import (
 	"github.com/urfave/cli"
 )
 
-type CLIBump func(string, bool, string, ...string) error
+type CLIBump func(string, bool, VersionSource, string, loadOptions, bumpOptions, releaseOptions, ...string) error
 
-func cliBump(bumpType string, ciMode bool, prefix string, anyLabels ...string) error {
+// bumpOptions defines how the next version should be determined
+type bumpOptions struct {
+	rules     string
+	changelog string
+	channels  string
+	build     string
+}
+
+// getBumpOptions retrieves the bump options from the flags of a command
+func getBumpOptions(c *cli.Context) bumpOptions {
+	return bumpOptions{
+		rules:     c.String("rules"),
+		changelog: c.String("changelog"),
+		channels:  c.String("channels"),
+		build:     c.String("build"),
+	}
+}
+
+func cliBump(bumpType string, ciMode bool, source VersionSource, prefix string, loading loadOptions, bumping bumpOptions, releasing releaseOptions, anyLabels ...string) error {
 	var label string
 	if len(anyLabels) > 0 {
 		label = anyLabels[0]
 	}
-	loader := GitLoader{}
-	semver, err := NewFrom(loader.Load("latest", prefix))
+	version, err := loading.load(source, prefix)
 	if err != nil {
 		panic(err)
 	}
-	currentSemver := semver.String()
-	confirmed := ciMode
-	switch bumpType {
-	case "help":
+	currentSemver := version.String()
+	loaded := *version
+	confirmed := ciMode || releasing.dryRun
+	if bumpType == "auto" {
+		if bumpType, err = detectBumpType(prefix, bumping.rules, releasing.paths...); err != nil {
+			panic(err)
+		}
+	}
+	if bumpType == "help" {
 		return fmt.Errorf("help requested")
+	}
+	if err := parseChannels(bumping.channels).checkBump(bumpType, version, label); err != nil {
+		panic(err)
+	}
+	switch bumpType {
 	case "major":
-		semver.BumpMajor()
+		version.BumpMajor()
 	case "minor":
-		semver.BumpMinor()
+		version.BumpMinor()
 	case "label":
-		semver.BumpLabel(label)
+		version.BumpLabel(label)
+	case "premajor":
+		version.BumpPremajor(label)
+	case "preminor":
+		version.BumpPreminor(label)
+	case "prepatch":
+		version.BumpPrepatch(label)
+	case "prerelease":
+		version.BumpPrerelease()
+	case "promote":
+		version.Promote(label)
+	case "release":
+		version.Release()
 	case "":
 		fallthrough
 	case "patch":
 		fallthrough
 	default:
-		semver.BumpPatch()
+		version.BumpPatch()
 		bumpType = "patch"
 	}
-	nextSemver := semver.String()
+	if len(bumping.build) > 0 {
+		if err := stampBuild(version, bumping.build, &loaded); err != nil {
+			panic(err)
+		}
+	}
+	nextSemver := version.String()
 	if !confirmed {
 		confirmed = bumpConfirm(os.Stdin, bumpType, currentSemver, nextSemver)
 	}
 	if confirmed {
-		gitTag(nextSemver)
+		if err := releasing.release(source, version, versionChange{bumpType, currentSemver, nextSemver}, bumping.changelog); err != nil {
+			panic(err)
+		}
 	}
 	return nil
 }
@@ -56,9 +102,9 @@ func getBumpCommand() cli.Command {
 			handleBump(c, cliBump)
 		},
 		Aliases:     []string{"b"},
-		ArgsUsage:   "<< major | minor | patch | label >>",
-		Description: "bumps the repositories version. if no arguments are specified, defaults to bumping the patch version",
-		Flags:       flags(flagPrefix, flagYes),
+		ArgsUsage:   "<< major | minor | patch | label <label> | auto | premajor <channel> | preminor <channel> | prepatch <channel> | prerelease | promote <channel> | release >>",
+		Description: "bumps the repositories version. if no arguments are specified, defaults to bumping the patch version. use 'auto' to determine the version to bump from the Conventional Commits made since the latest version. use --component to bump a component of a monorepo on its own. use 'premajor', 'preminor' or 'prepatch' to start a prerelease on a channel, 'prerelease' to bump it, 'promote' to move it to a later channel and 'release' to release it",
+		Flags:       flags(flagConfig, flagUse, flagUsePath, flagPrefix, flagComponent, flagTagPattern, flagMode, flagInitial, flagStrict, flagYes, flagAnnotate, flagMessage, flagSign, flagSignKey, flagPush, flagRollback, flagRules, flagChannels, flagBuild, flagChangelog, flagFile, flagDryRun, flagBranch),
 		Name:        "bump",
 		Usage:       "bumps the repository's version",
 	}
@@ -68,14 +114,28 @@ func handleBump(c *cli.Context, bump CLIBump) error {
 	defer func() {
 		if r := recover(); r != nil {
 			fmt.Println(r)
-			os.Exit(1)
+			os.Exit(exitCodeFor(r))
 		}
 	}()
+	configuration, err := applyConfig(c)
+	if err != nil {
+		panic(err)
+	}
 	section := c.Args().First()
-	prefix := c.String("prefix")
+	prefix, err := getComponentOptions(c, configuration).prefix(c.String("prefix"))
+	if err != nil {
+		panic(err)
+	}
+	loading := getLoadOptions(c)
 	label := c.Args().Get(1)
 	yes := c.Bool("yes")
-	if err := bump(section, yes, prefix, label); err != nil {
+	bumping := getBumpOptions(c)
+	releasing := getReleaseOptions(c, configuration)
+	source, err := getVersionSource(c)
+	if err != nil {
+		panic(err)
+	}
+	if err := bump(section, yes, source, prefix, loading, bumping, releasing, label); err != nil {
 		cli.ShowSubcommandHelp(c)
 		return err
 	}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CLIBumpTestSuite struct {
+	GitRepositoryTestSuite
+	loading loadOptions
+	bumping bumpOptions
+}
+
+func TestCLIBump(t *testing.T) {
+	suite.Run(t, new(CLIBumpTestSuite))
+}
+
+func (s *CLIBumpTestSuite) SetupTest() {
+	s.GitRepositoryTestSuite.SetupTest()
+	s.loading = loadOptions{mode: "latest", initial: "0.0.0"}
+	s.bumping = bumpOptions{rules: defaultBumpRules, channels: defaultChannels}
+	s.commit("initial commit")
+}
+
+func (s *CLIBumpTestSuite) Test_cliBump() {
+	s.git("tag", "1.2.3")
+	assert.Nil(s.T(), cliBump("minor", true, &GitLoader{}, "", s.loading, s.bumping, releaseOptions{}))
+	assert.Contains(s.T(), s.git("tag", "--points-at", "HEAD"), "1.3.0")
+}
+
+func (s *CLIBumpTestSuite) Test_cliBump_firstTag() {
+	assert.Nil(s.T(), cliBump("minor", true, &GitLoader{}, "", s.loading, s.bumping, releaseOptions{}))
+	assert.Equal(s.T(), "0.1.0", s.git("tag", "--list"))
+}
+
+func (s *CLIBumpTestSuite) Test_cliBump_firstMajorTag() {
+	assert.Nil(s.T(), cliBump("major", true, &GitLoader{}, "v", s.loading, s.bumping, releaseOptions{}))
+	assert.Equal(s.T(), "v1.0.0", s.git("tag", "--list"))
+}
+
+func (s *CLIBumpTestSuite) Test_cliBump_strictWithoutTags() {
+	s.loading.strict = true
+	assert.Panics(s.T(), func() { cliBump("minor", true, &GitLoader{}, "", s.loading, s.bumping, releaseOptions{}) })
+	assert.Empty(s.T(), s.git("tag", "--list"))
+}
+
+func (s *CLIBumpTestSuite) Test_cliBump_help() {
+	assert.EqualError(s.T(), cliBump("help", true, &GitLoader{}, "", s.loading, s.bumping, releaseOptions{}), "help requested")
+}
+
+func (s *CLIBumpTestSuite) Test_cliBump_auto() {
+	s.git("tag", "1.2.3")
+	s.commit("feat: a new feature")
+	s.commit("fix: a bug fix")
+	assert.Nil(s.T(), cliBump("auto", true, &GitLoader{}, "", s.loading, s.bumping, releaseOptions{}))
+	assert.Equal(s.T(), "1.3.0", s.git("tag", "--points-at", "HEAD"))
+}
+
+func (s *CLIBumpTestSuite) Test_cliBump_autoWithoutReleasableChanges() {
+	s.git("tag", "1.2.3")
+	s.commit("chore: tidy up")
+	assert.Panics(s.T(), func() { cliBump("auto", true, &GitLoader{}, "", s.loading, s.bumping, releaseOptions{}) })
+	assert.Equal(s.T(), "1.2.3", s.git("tag", "--list"))
+}
+
+func (s *CLIBumpTestSuite) Test_cliBump_withChangelog() {
+	s.git("tag", "1.2.3")
+	s.commit("feat: a new feature")
+	s.bumping.changelog = "CHANGELOG.md"
+	assert.Nil(s.T(), cliBump("auto", true, &GitLoader{}, "", s.loading, s.bumping, releaseOptions{}))
+	assert.Equal(s.T(), "1.3.0", s.git("tag", "--points-at", "HEAD"))
+	assert.Contains(s.T(), s.git("show", "1.3.0:CHANGELOG.md"), "### Added\n- a new feature")
+}
+
+func (s *CLIBumpTestSuite) Test_cliBump_dryRun() {
+	s.git("tag", "1.2.3")
+	assert.Nil(s.T(), cliBump("minor", false, &GitLoader{}, "", s.loading, s.bumping, releaseOptions{dryRun: true}))
+	assert.Equal(s.T(), "1.2.3", s.git("tag", "--list"))
+}
+
+func (s *CLIBumpTestSuite) Test_cliBump_prereleaseWorkflow() {
+	s.git("tag", "1.4.2")
+	steps := []struct {
+		bumpType string
+		channel  string
+		expected string
+	}{
+		{"premajor", "beta", "2.0.0-beta.0"},
+		{"prerelease", "", "2.0.0-beta.1"},
+		{"promote", "rc", "2.0.0-rc.0"},
+		{"prerelease", "", "2.0.0-rc.1"},
+		{"release", "", "2.0.0"},
+	}
+	for _, step := range steps {
+		s.commit(step.bumpType)
+		assert.Nil(s.T(), cliBump(step.bumpType, true, &GitLoader{}, "", s.loading, s.bumping, releaseOptions{}, step.channel))
+		assert.Equal(s.T(), step.expected, s.git("tag", "--points-at", "HEAD"))
+	}
+}
+
+func (s *CLIBumpTestSuite) Test_cliBump_promoteBackwards() {
+	s.git("tag", "2.0.0-rc.1")
+	s.commit("second commit")
+	assert.Panics(s.T(), func() { cliBump("promote", true, &GitLoader{}, "", s.loading, s.bumping, releaseOptions{}, "beta") })
+	assert.Panics(s.T(), func() { cliBump("label", true, &GitLoader{}, "", s.loading, s.bumping, releaseOptions{}, "alpha") })
+	assert.Equal(s.T(), "2.0.0-rc.1", s.git("tag", "--list"))
+}
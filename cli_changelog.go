@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
+)
+
+type CLIChangelog func(string, string, string, string, ...string) error
+
+func cliChangelog(from string, to string, prefix string, changelog string, paths ...string) error {
+	if from == "help" {
+		return fmt.Errorf("help requested")
+	}
+	if !semver.IsSemverLike(to, prefix) {
+		if toPrefix, _, ok := splitTag(to); ok {
+			if len(prefix) > 0 {
+				panic(&causedError{ErrInvalidVersion, fmt.Sprintf("invalid --to '%s' specified, expected a version with the prefix '%s'", to, prefix)})
+			}
+			prefix = toPrefix
+		}
+	}
+	revisionRange, err := changelogRange(from, to, prefix)
+	if err != nil {
+		panic(err)
+	}
+	messages, err := gitLog(revisionRange, paths...)
+	if err != nil {
+		panic(err)
+	}
+	version := ""
+	date := ""
+	if semver.IsSemverLike(to, prefix) {
+		version = to
+		if date, err = gitCommitDate(to); err != nil {
+			panic(err)
+		}
+	}
+	entry := renderChangelog(version, date, messages)
+	if len(changelog) == 0 {
+		fmt.Print(entry)
+		return nil
+	}
+	if err := prependChangelog(changelog, entry); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+func getChangelogCommand() cli.Command {
+	return cli.Command{
+		Action: func(c *cli.Context) {
+			handleChangelog(c, cliChangelog)
+		},
+		Aliases:     []string{"c"},
+		Description: "generates a Keep a Changelog entry from the Conventional Commits between two refs. defaults to the commits since the latest semver tag, limited to those changing the directory of the component if --component is specified, and prints the entry unless --changelog is specified",
+		Flags:       flags(flagConfig, flagPrefix, flagComponent, flagTagPattern, flagFrom, flagTo, flagChangelog),
+		Name:        "changelog",
+		Usage:       "generates a changelog from the git history",
+	}
+}
+
+func handleChangelog(c *cli.Context, changelog CLIChangelog) error {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println(r)
+			os.Exit(exitCodeFor(r))
+		}
+	}()
+	configuration, err := applyConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	from := c.String("from")
+	if c.Args().First() == "help" {
+		from = "help"
+	}
+	to := c.String("to")
+	components := getComponentOptions(c, configuration)
+	prefix, err := components.prefix(c.String("prefix"))
+	if err != nil {
+		panic(err)
+	}
+	file := c.String("changelog")
+
+	if err := changelog(from, to, prefix, file, components.paths()...); err != nil {
+		cli.ShowSubcommandHelp(c)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CLIChangelogTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestCLIChangelog(t *testing.T) {
+	suite.Run(t, new(CLIChangelogTestSuite))
+}
+
+// read returns the contents of the file at :path
+func (s *CLIChangelogTestSuite) read(path string) string {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+	return string(contents)
+}
+
+// setupHistory creates the releases v1.0.0 and v1.1.0 followed by an
+// unreleased change
+func (s *CLIChangelogTestSuite) setupHistory() {
+	s.commit("feat: first feature")
+	s.git("tag", "v1.0.0")
+	s.commit("feat: second feature")
+	s.commit("fix: first fix")
+	s.git("tag", "v1.1.0")
+	s.commit("fix: unreleased fix")
+}
+
+func (s *CLIChangelogTestSuite) Test_cliChangelog() {
+	s.setupHistory()
+	assert.Nil(s.T(), cliChangelog("", "HEAD", "v", ""))
+	assert.Nil(s.T(), cliChangelog("", "HEAD", "v", "CHANGELOG.md"))
+	changelog := s.read("CHANGELOG.md")
+	assert.Contains(s.T(), changelog, "## [Unreleased]\n")
+	assert.Contains(s.T(), changelog, "- unreleased fix")
+	assert.NotContains(s.T(), changelog, "first fix")
+	assert.EqualError(s.T(), cliChangelog("help", "HEAD", "", ""), "help requested")
+}
+
+func (s *CLIChangelogTestSuite) Test_cliChangelog_to() {
+	s.setupHistory()
+	assert.Nil(s.T(), cliChangelog("", "v1.1.0", "v", "CHANGELOG.md"))
+	changelog := s.read("CHANGELOG.md")
+	assert.Contains(s.T(), changelog, "## [v1.1.0] - ")
+	assert.Contains(s.T(), changelog, "- second feature")
+	assert.Contains(s.T(), changelog, "- first fix")
+	assert.NotContains(s.T(), changelog, "first feature")
+	assert.NotContains(s.T(), changelog, "unreleased fix")
+}
+
+func (s *CLIChangelogTestSuite) Test_cliChangelog_toDetectsPrefix() {
+	s.setupHistory()
+	assert.Nil(s.T(), cliChangelog("", "v1.1.0", "", "CHANGELOG.md"))
+	changelog := s.read("CHANGELOG.md")
+	assert.Contains(s.T(), changelog, "## [v1.1.0] - ")
+	assert.NotContains(s.T(), changelog, "first feature")
+}
+
+func (s *CLIChangelogTestSuite) Test_cliChangelog_toWithOtherPrefix() {
+	s.setupHistory()
+	defer func() {
+		err := recover().(error)
+		assert.Equal(s.T(), ErrInvalidVersion, errorCause(err))
+		assert.EqualError(s.T(), err, "invalid --to 'v1.1.0' specified, expected a version with the prefix 'api/v'")
+	}()
+	cliChangelog("", "v1.1.0", "api/v", "")
+}
+
+func (s *CLIChangelogTestSuite) Test_cliChangelog_from() {
+	s.setupHistory()
+	assert.Nil(s.T(), cliChangelog("v1.0.0", "HEAD", "v", "CHANGELOG.md"))
+	changelog := s.read("CHANGELOG.md")
+	assert.Contains(s.T(), changelog, "## [Unreleased]\n")
+	assert.Contains(s.T(), changelog, "- second feature")
+	assert.Contains(s.T(), changelog, "- unreleased fix")
+	assert.NotContains(s.T(), changelog, "first feature")
+}
+
+func (s *CLIChangelogTestSuite) Test_cliChangelog_prependsToFile() {
+	s.setupHistory()
+	assert.Nil(s.T(), cliChangelog("", "v1.0.0", "v", "CHANGELOG.md"))
+	assert.Nil(s.T(), cliChangelog("", "v1.1.0", "v", "CHANGELOG.md"))
+	changelog := s.read("CHANGELOG.md")
+	assert.True(s.T(), strings.Index(changelog, "## [v1.1.0]") < strings.Index(changelog, "## [v1.0.0]"))
+	assert.Contains(s.T(), changelog, "- first feature")
+}
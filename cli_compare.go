@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
+)
+
+// comparisonOperators maps the comparison subcommands of `compare` to the
+// result of semver.Compare they hold for and their description
+var comparisonOperators = map[string]struct {
+	result      int
+	description string
+}{
+	"gt": {1, "greater than"},
+	"lt": {-1, "lower than"},
+	"eq": {0, "equal to"},
+}
+
+type CLICompare func(string, string, string, bool) error
+
+func cliCompare(a string, b string, prefix string, exitCode bool) error {
+	if a == "help" || a == "" || b == "" {
+		return fmt.Errorf("help requested")
+	}
+	versionA, versionB, err := parseComparedVersions(a, b, prefix)
+	if err != nil {
+		panic(err)
+	}
+	result := semver.Compare(versionA, versionB)
+	if !exitCode {
+		fmt.Println(result)
+		return nil
+	}
+	switch result {
+	case -1:
+		panic(&causedError{ErrVersionLower, fmt.Sprintf("%s is lower than %s", versionA, versionB)})
+	case 1:
+		panic(&causedError{ErrVersionHigher, fmt.Sprintf("%s is higher than %s", versionA, versionB)})
+	}
+	return nil
+}
+
+type CLICompareAs func(string, string, string, string) error
+
+func cliCompareAs(operator string, a string, b string, prefix string) error {
+	if a == "help" || a == "" || b == "" {
+		return fmt.Errorf("help requested")
+	}
+	versionA, versionB, err := parseComparedVersions(a, b, prefix)
+	if err != nil {
+		panic(err)
+	}
+	comparison := comparisonOperators[operator]
+	if semver.Compare(versionA, versionB) == comparison.result {
+		return nil
+	}
+	panic(&causedError{ErrNotSatisfied, fmt.Sprintf("%s is not %s %s", versionA, comparison.description, versionB)})
+}
+
+// parseComparedVersions parses the versions :a and :b, which may or may not
+// include the :prefix, returning ErrInvalidVersion if either is invalid
+func parseComparedVersions(a string, b string, prefix string) (semver.ISemver, semver.ISemver, error) {
+	versionA, err := parseVersionArgument(a, prefix)
+	if err != nil {
+		return nil, nil, &causedError{ErrInvalidVersion, fmt.Sprintf("invalid semver '%s' specified: %s", a, err)}
+	}
+	versionB, err := parseVersionArgument(b, prefix)
+	if err != nil {
+		return nil, nil, &causedError{ErrInvalidVersion, fmt.Sprintf("invalid semver '%s' specified: %s", b, err)}
+	}
+	return versionA, versionB, nil
+}
+
+func getCompareCommand() cli.Command {
+	var subcommands []cli.Command
+	for _, operator := range []string{"gt", "lt", "eq"} {
+		subcommands = append(subcommands, getCompareAsCommand(operator))
+	}
+	return cli.Command{
+		Action: func(c *cli.Context) {
+			handleCompare(c, cliCompare)
+		},
+		ArgsUsage:   "<< version a >> << version b >>",
+		Description: "compares two versions, printing -1 if the first is lower than the second, 0 if they are of equal precedence and 1 if it is higher. use --exit-code to exit with code 0, 7 or 8 respectively instead. use 'gt', 'lt' or 'eq' to check a comparison holds, exiting with code 6 if it does not",
+		Flags:       flags(flagConfig, flagPrefix, flagExitCode),
+		Name:        "compare",
+		Subcommands: subcommands,
+		Usage:       "compares two versions",
+	}
+}
+
+func getCompareAsCommand(operator string) cli.Command {
+	description := comparisonOperators[operator].description
+	return cli.Command{
+		Action: func(c *cli.Context) {
+			handleCompareAs(c, operator, cliCompareAs)
+		},
+		ArgsUsage:   "<< version a >> << version b >>",
+		Description: fmt.Sprintf("checks whether the first version is %s the second, exiting with code 6 if it is not", description),
+		Flags:       flags(flagConfig, flagPrefix),
+		Name:        operator,
+		Usage:       fmt.Sprintf("checks a version is %s another", description),
+	}
+}
+
+func handleCompare(c *cli.Context, compare CLICompare) error {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println(r)
+			os.Exit(exitCodeFor(r))
+		}
+	}()
+	if _, err := applyConfig(c); err != nil {
+		panic(err)
+	}
+	a := c.Args().First()
+	b := c.Args().Get(1)
+	prefix := c.String("prefix")
+	exitCode := c.Bool("exit-code")
+
+	if err := compare(a, b, prefix, exitCode); err != nil {
+		cli.ShowSubcommandHelp(c)
+		return err
+	}
+	return nil
+}
+
+func handleCompareAs(c *cli.Context, operator string, compareAs CLICompareAs) error {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println(r)
+			os.Exit(exitCodeFor(r))
+		}
+	}()
+	if _, err := applyConfig(c); err != nil {
+		panic(err)
+	}
+	a := c.Args().First()
+	b := c.Args().Get(1)
+	prefix := c.String("prefix")
+
+	if err := compareAs(operator, a, b, prefix); err != nil {
+		cli.ShowSubcommandHelp(c)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CLICompareTestSuite struct {
+	suite.Suite
+}
+
+func TestCLICompare(t *testing.T) {
+	suite.Run(t, new(CLICompareTestSuite))
+}
+
+// recovered runs :call and returns the error it panicked with, if any
+func (s *CLICompareTestSuite) recovered(call func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = r.(error)
+		}
+	}()
+	call()
+	return nil
+}
+
+func (s *CLICompareTestSuite) Test_cliCompare() {
+	assert.Nil(s.T(), cliCompare("1.0.0", "2.0.0", "", false))
+	assert.Nil(s.T(), cliCompare("v1.0.0", "1.0.0+build.1", "v", true))
+	assert.EqualError(s.T(), cliCompare("help", "", "", false), "help requested")
+	assert.EqualError(s.T(), cliCompare("1.0.0", "", "", false), "help requested")
+}
+
+func (s *CLICompareTestSuite) Test_cliCompare_exitCode() {
+	err := s.recovered(func() { cliCompare("1.0.0-rc.1", "1.0.0", "", true) })
+	assert.Equal(s.T(), ErrVersionLower, errorCause(err))
+	assert.EqualError(s.T(), err, "1.0.0-rc.1 is lower than 1.0.0")
+	err = s.recovered(func() { cliCompare("1.10.0", "1.9.0", "", true) })
+	assert.Equal(s.T(), ErrVersionHigher, errorCause(err))
+	assert.EqualError(s.T(), err, "1.10.0 is higher than 1.9.0")
+	err = s.recovered(func() { cliCompare("1.0.0-RC.1", "1.0.0-rc.1", "", true) })
+	assert.Equal(s.T(), ErrVersionLower, errorCause(err))
+	assert.EqualError(s.T(), err, "1.0.0-RC.1 is lower than 1.0.0-rc.1")
+}
+
+func (s *CLICompareTestSuite) Test_cliCompare_invalid() {
+	err := s.recovered(func() { cliCompare("1.0", "1.0.0", "", false) })
+	assert.Equal(s.T(), ErrInvalidVersion, errorCause(err))
+	assert.Contains(s.T(), err.Error(), "invalid semver '1.0' specified")
+	err = s.recovered(func() { cliCompare("1.0.0", "x1.0.0", "v", false) })
+	assert.Equal(s.T(), ErrInvalidVersion, errorCause(err))
+	assert.Contains(s.T(), err.Error(), "invalid semver 'x1.0.0' specified")
+}
+
+func (s *CLICompareTestSuite) Test_cliCompareAs() {
+	assert.Nil(s.T(), cliCompareAs("gt", "2.0.0", "1.0.0", ""))
+	assert.Nil(s.T(), cliCompareAs("lt", "v1.0.0", "v1.0.1", "v"))
+	assert.Nil(s.T(), cliCompareAs("eq", "1.0.0+build.1", "1.0.0+build.2", ""))
+	assert.EqualError(s.T(), cliCompareAs("gt", "help", "", ""), "help requested")
+	err := s.recovered(func() { cliCompareAs("gt", "1.0.0", "1.0.0", "") })
+	assert.Equal(s.T(), ErrNotSatisfied, errorCause(err))
+	assert.EqualError(s.T(), err, "1.0.0 is not greater than 1.0.0")
+	err = s.recovered(func() { cliCompareAs("eq", "1.0.0", "1.0", "") })
+	assert.Equal(s.T(), ErrInvalidVersion, errorCause(err))
+	assert.Contains(s.T(), err.Error(), "invalid semver '1.0' specified")
+	err = s.recovered(func() { cliCompareAs("eq", "1.0.0-RC.1", "1.0.0-rc.1", "") })
+	assert.Equal(s.T(), ErrNotSatisfied, errorCause(err))
+	assert.EqualError(s.T(), err, "1.0.0-RC.1 is not equal to 1.0.0-rc.1")
+}
+
+func (s *CLICompareTestSuite) Test_handleCompare() {
+	var a, b string
+	c := newTestContext(flags(flagConfig, flagPrefix, flagExitCode), "1.0.0-RC.1", "1.0.0-rc.1")
+	assert.Nil(s.T(), handleCompare(c, func(versionA string, versionB string, prefix string, exitCode bool) error {
+		a, b = versionA, versionB
+		return nil
+	}))
+	assert.Equal(s.T(), "1.0.0-RC.1", a)
+	assert.Equal(s.T(), "1.0.0-rc.1", b)
+	assert.Nil(s.T(), handleCompareAs(c, "eq", func(operator string, versionA string, versionB string, prefix string) error {
+		a, b = versionA, versionB
+		return nil
+	}))
+	assert.Equal(s.T(), "1.0.0-RC.1", a)
+	assert.Equal(s.T(), "1.0.0-rc.1", b)
+}
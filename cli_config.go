@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+	yaml "gopkg.in/yaml.v2"
+)
+
+type CLIConfig func(string, config) error
+
+func cliConfig(section string, effective config) error {
+	if section == "help" {
+		return fmt.Errorf("help requested")
+	}
+	output, err := yaml.Marshal(effective)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(string(output))
+	return nil
+}
+
+func getConfigCommand() cli.Command {
+	return cli.Command{
+		Action: func(c *cli.Context) {
+			handleConfig(c, cliConfig)
+		},
+		Description: "prints the configuration in effect after applying the flags, the GOSEMVER_* environment variables and the configuration file in the root of the repository, in that order of precedence",
+		Flags:       flags(flagConfig, flagUse, flagUsePath, flagPrefix, flagMode, flagInitial, flagAnnotate, flagMessage, flagSign, flagSignKey, flagPush, flagRules, flagChannels, flagChangelog, flagFile, flagTagPattern),
+		Name:        "config",
+		Usage:       "prints the effective configuration",
+	}
+}
+
+func handleConfig(c *cli.Context, printConfig CLIConfig) error {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println(r)
+			os.Exit(exitCodeFor(r))
+		}
+	}()
+	configuration, err := applyConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	section := strings.ToLower(c.Args().First())
+	effective, err := effectiveConfig(c, configuration)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := printConfig(section, effective); err != nil {
+		cli.ShowSubcommandHelp(c)
+		return err
+	}
+	return nil
+}
+
+// effectiveConfig returns the configuration in effect for a command after
+// its flags have been set from the :configuration
+func effectiveConfig(c *cli.Context, configuration config) (config, error) {
+	rules, err := parseBumpRules(c.String("rules"))
+	if err != nil {
+		return config{}, err
+	}
+	return config{
+		Prefix:     c.String("prefix"),
+		Source:     c.String("use"),
+		SourcePath: c.String("use-path"),
+		Mode:       c.String("mode"),
+		Initial:    c.String("initial"),
+		Annotate:   c.Bool("annotate"),
+		Message:    c.String("message"),
+		Sign:       c.Bool("sign"),
+		SignKey:    c.String("sign-key"),
+		Push:       c.String("push"),
+		Files:      c.StringSlice("file"),
+		Rules:      rules,
+		Channels:   parseChannels(c.String("channels")),
+		Changelog:  c.String("changelog"),
+		Branches:   configuration.Branches,
+		TagPattern: c.String("tag-pattern"),
+		Components: configuration.Components,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
+)
+
+// diffOutputs lists the formats supported by --output for `diff`
+var diffOutputs = []string{"text", "json"}
+
+// versionDiff describes the change between two versions
+type versionDiff struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Change is the most significant section which changed as described by
+	// semver.Diff, or 'none' if the versions are the same
+	Change string `json:"change"`
+	// Downgrade is true if the version changed to is lower
+	Downgrade bool `json:"downgrade"`
+}
+
+// newVersionDiff describes the change from the version :from to :to
+func newVersionDiff(from semver.ISemver, to semver.ISemver) versionDiff {
+	change := semver.Diff(from, to)
+	if len(change) == 0 {
+		change = "none"
+	}
+	return versionDiff{from.String(), to.String(), change, semver.Compare(to, from) < 0}
+}
+
+// renderDiffOutput renders the :diff in the :output, one of diffOutputs
+func renderDiffOutput(diff versionDiff, output string) (string, error) {
+	switch output {
+	case "", "text":
+		if diff.Downgrade {
+			return diff.Change + " (downgrade)\n", nil
+		}
+		return diff.Change + "\n", nil
+	case "json":
+		rendered, err := json.MarshalIndent(diff, "", "  ")
+		return string(rendered) + "\n", err
+	}
+	return "", fmt.Errorf("invalid output '%s' specified, expected one of %s", output, strings.Join(diffOutputs, ", "))
+}
+
+type CLIDiff func(string, string, string, string) error
+
+func cliDiff(from string, to string, prefix string, output string) error {
+	if from == "help" || from == "" || to == "" {
+		return fmt.Errorf("help requested")
+	}
+	fromVersion, toVersion, err := parseComparedVersions(from, to, prefix)
+	if err != nil {
+		panic(err)
+	}
+	rendered, err := renderDiffOutput(newVersionDiff(fromVersion, toVersion), output)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(rendered)
+	return nil
+}
+
+func getDiffCommand() cli.Command {
+	return cli.Command{
+		Action: func(c *cli.Context) {
+			handleDiff(c, cliDiff)
+		},
+		ArgsUsage:   "<< from version >> << to version >>",
+		Description: "prints the most significant section which changed between two versions, one of 'major', 'minor', 'patch', 'premajor', 'preminor', 'prepatch', 'prerelease', 'build' or 'none', noting whether the change is a downgrade",
+		Flags:       flags(flagConfig, flagPrefix, flagDiffOutput),
+		Name:        "diff",
+		Usage:       "describes the change between two versions",
+	}
+}
+
+func handleDiff(c *cli.Context, diff CLIDiff) error {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println(r)
+			os.Exit(exitCodeFor(r))
+		}
+	}()
+	if _, err := applyConfig(c); err != nil {
+		panic(err)
+	}
+	from := c.Args().First()
+	to := c.Args().Get(1)
+	prefix := c.String("prefix")
+	output := strings.ToLower(c.String("output"))
+
+	if err := diff(from, to, prefix, output); err != nil {
+		cli.ShowSubcommandHelp(c)
+		return err
+	}
+	return nil
+}
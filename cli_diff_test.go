@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/zephinzer/semver/semver"
+)
+
+type CLIDiffTestSuite struct {
+	suite.Suite
+}
+
+func TestCLIDiff(t *testing.T) {
+	suite.Run(t, new(CLIDiffTestSuite))
+}
+
+func (s *CLIDiffTestSuite) Test_newVersionDiff() {
+	assert.Equal(s.T(), versionDiff{"1.2.3", "1.3.0", "minor", false}, newVersionDiff(semver.MustParse("1.2.3"), semver.MustParse("1.3.0")))
+	assert.Equal(s.T(), versionDiff{"2.0.0-rc.1", "1.9.0", "premajor", true}, newVersionDiff(semver.MustParse("2.0.0-rc.1"), semver.MustParse("1.9.0")))
+	assert.Equal(s.T(), versionDiff{"1.2.3", "1.2.3", "none", false}, newVersionDiff(semver.MustParse("1.2.3"), semver.MustParse("1.2.3")))
+}
+
+func (s *CLIDiffTestSuite) Test_renderDiffOutput() {
+	output, err := renderDiffOutput(versionDiff{"1.2.3", "1.3.0", "minor", false}, "text")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "minor\n", output)
+	output, err = renderDiffOutput(versionDiff{"1.3.0", "1.2.3", "minor", true}, "")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "minor (downgrade)\n", output)
+	output, err = renderDiffOutput(versionDiff{"1.3.0", "1.2.3", "minor", true}, "json")
+	assert.Nil(s.T(), err)
+	assert.JSONEq(s.T(), `{"from": "1.3.0", "to": "1.2.3", "change": "minor", "downgrade": true}`, output)
+	_, err = renderDiffOutput(versionDiff{}, "yaml")
+	assert.EqualError(s.T(), err, "invalid output 'yaml' specified, expected one of text, json")
+}
+
+func (s *CLIDiffTestSuite) Test_cliDiff() {
+	assert.Nil(s.T(), cliDiff("v1.2.3", "1.3.0", "v", "json"))
+	assert.EqualError(s.T(), cliDiff("help", "", "", ""), "help requested")
+	assert.EqualError(s.T(), cliDiff("1.2.3", "", "", ""), "help requested")
+	assert.Panics(s.T(), func() { cliDiff("1.2", "1.3.0", "", "") })
+	assert.Panics(s.T(), func() { cliDiff("1.2.3", "1.3.0", "", "xml") })
+}
+
+func (s *CLIDiffTestSuite) Test_cliDiff_invalid() {
+	defer func() {
+		err := recover().(error)
+		assert.Equal(s.T(), ErrInvalidVersion, errorCause(err))
+		assert.Contains(s.T(), err.Error(), "invalid semver '1.3' specified")
+	}()
+	cliDiff("1.2.3", "1.3", "", "")
+}
+
+func (s *CLIDiffTestSuite) Test_handleDiff() {
+	var from, to, output string
+	c := newTestContext(flags(flagConfig, flagPrefix, flagDiffOutput), "--output", "JSON", "1.0.0-Alpha", "1.0.0-alpha")
+	assert.Nil(s.T(), handleDiff(c, func(fromVersion string, toVersion string, prefix string, diffOutput string) error {
+		from, to, output = fromVersion, toVersion, diffOutput
+		return nil
+	}))
+	assert.Equal(s.T(), "1.0.0-Alpha", from)
+	assert.Equal(s.T(), "1.0.0-alpha", to)
+	assert.Equal(s.T(), "json", output)
+	assert.Equal(s.T(), versionDiff{"1.0.0-Alpha", "1.0.0-alpha", "prerelease", false}, newVersionDiff(semver.MustParse(from), semver.MustParse(to)))
+}
@@ -6,39 +6,102 @@ import (
 	"strings"
 
 	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
 )
 
-type CLIGet func(string, string, string) error
+type CLIGet func(string, VersionSource, string, loadOptions, getOptions) error
 
-func cliGet(section string, using string, prefix string) error {
-	var semver ISemver
+// getOptions defines how the version should be printed
+type getOptions struct {
+	// output is the format to print all fields of the version in, one of
+	// outputFormats. only the section is printed if it is empty
+	output string
+	// format is the name of one of the formatPresets or a text/template to
+	// print the version with
+	format string
+	// build is the template for the build metadata to set on the version
+	build string
+	// dev prints the development version of HEAD instead of the version
+	dev bool
+	// maxSatisfying is a constraint the highest satisfying version is
+	// printed for instead of the version selected by the load options
+	maxSatisfying string
+}
+
+// getGetOptions retrieves the get options from the flags of a command
+func getGetOptions(c *cli.Context) getOptions {
+	return getOptions{
+		output:        strings.ToLower(c.String("output")),
+		format:        c.String("format"),
+		build:         c.String("build"),
+		dev:           c.Bool("dev"),
+		maxSatisfying: c.String("max-satisfying"),
+	}
+}
+
+func cliGet(section string, source VersionSource, prefix string, loading loadOptions, getting getOptions) error {
+	if section == "help" {
+		return fmt.Errorf("help requested")
+	}
+	var version *semver.Semver
 	var err error
-	switch using {
-	case "git":
-		loader := GitLoader{}
-		semver, err = NewFrom(loader.Load("latest", prefix))
+	if len(getting.maxSatisfying) > 0 {
+		version, err = maxSatisfying(source, prefix, getting.maxSatisfying)
+	} else {
+		version, err = loading.load(source, prefix)
+	}
+	if err != nil {
+		panic(err)
+	}
+	loaded := *version
+	if getting.dev && len(getting.build) > 0 {
+		panic(fmt.Errorf("only one of --dev or --build can be specified"))
+	}
+	if getting.dev {
+		if err := stampDevelopment(version, &loaded); err != nil {
+			panic(err)
+		}
+	}
+	if len(getting.build) > 0 {
+		if err := stampBuild(version, getting.build, &loaded); err != nil {
+			panic(err)
+		}
+	}
+
+	if len(getting.output) > 0 && len(getting.format) > 0 {
+		panic(fmt.Errorf("only one of --output or --format can be specified"))
+	}
+	if len(getting.output) > 0 || len(getting.format) > 0 {
+		info, err := newVersionInfo(version, &loaded, source)
 		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
+			panic(err)
 		}
-	default:
-		fmt.Println("invalid engine '" + using + "' specified")
-		os.Exit(1)
+		var output string
+		if len(getting.output) > 0 {
+			output, err = renderOutput(info, getting.output)
+		} else if output, err = renderFormat(info, getting.format); err == nil && !strings.HasSuffix(output, "\n") {
+			output += "\n"
+		}
+		if err != nil {
+			panic(err)
+		}
+		fmt.Print(output)
+		return nil
 	}
 
 	switch section {
-	case "help":
-		return fmt.Errorf("help requested")
 	case "major":
-		fmt.Println(semver.GetMajorInt())
+		fmt.Println(version.GetMajorInt())
 	case "minor":
-		fmt.Println(semver.GetMinorInt())
+		fmt.Println(version.GetMinorInt())
 	case "patch":
-		fmt.Println(semver.GetPatchInt())
+		fmt.Println(version.GetPatchInt())
 	case "label":
-		fmt.Println(semver.GetLabel())
+		fmt.Println(version.GetLabel())
+	case "build":
+		fmt.Println(version.GetBuild())
 	default:
-		fmt.Println(semver)
+		fmt.Println(version)
 	}
 
 	return nil
@@ -50,9 +113,9 @@ func getGetCommand() cli.Command {
 			handleGet(c, cliGet)
 		},
 		Aliases:     []string{"g"},
-		ArgsUsage:   "<< major | minor | patch | label >>",
-		Description: "gets the version of the application under development - to retrieve a specific section, use one of 'major', 'minor', 'patch', 'label', otherwise the entire version will be returned if no arguments are specified.",
-		Flags:       flags(flagUse, flagPrefix, flagMode),
+		ArgsUsage:   "<< major | minor | patch | label | build >>",
+		Description: "gets the version of the application under development - to retrieve a specific section, use one of 'major', 'minor', 'patch', 'label', 'build', otherwise the entire version will be returned if no arguments are specified. use --output to print all fields of the version at once, or --format to print them using a template. use --dev to print a unique development version for untagged commits, and --max-satisfying to print the highest version satisfying a constraint",
+		Flags:       flags(flagConfig, flagUse, flagUsePath, flagPrefix, flagComponent, flagTagPattern, flagMode, flagInitial, flagStrict, flagOutput, flagFormat, flagBuild, flagDev, flagMaxSatisfying),
 		Name:        "get",
 		Usage:       "gets the repository's latest/highest tag",
 	}
@@ -62,14 +125,26 @@ func handleGet(c *cli.Context, get CLIGet) error {
 	defer func() {
 		if r := recover(); r != nil {
 			fmt.Println(r)
-			os.Exit(1)
+			os.Exit(exitCodeFor(r))
 		}
 	}()
+	configuration, err := applyConfig(c)
+	if err != nil {
+		panic(err)
+	}
 	section := strings.ToLower(c.Args().First())
-	using := strings.ToLower(c.String("use"))
-	prefix := strings.ToLower(c.String("prefix"))
+	source, err := getVersionSource(c)
+	if err != nil {
+		panic(err)
+	}
+	prefix, err := getComponentOptions(c, configuration).prefix(strings.ToLower(c.String("prefix")))
+	if err != nil {
+		panic(err)
+	}
+	loading := getLoadOptions(c)
+	getting := getGetOptions(c)
 
-	if err := get(section, using, prefix); err != nil {
+	if err := get(section, source, prefix, loading, getting); err != nil {
 		cli.ShowSubcommandHelp(c)
 		return err
 	}
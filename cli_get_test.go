@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CLIGetTestSuite struct {
+	GitRepositoryTestSuite
+	loading loadOptions
+}
+
+func TestCLIGet(t *testing.T) {
+	suite.Run(t, new(CLIGetTestSuite))
+}
+
+func (s *CLIGetTestSuite) SetupTest() {
+	s.GitRepositoryTestSuite.SetupTest()
+	s.loading = loadOptions{mode: "latest", initial: "0.0.0"}
+	s.commit("initial commit")
+	s.git("tag", "1.2.3")
+}
+
+func (s *CLIGetTestSuite) Test_cliGet() {
+	assert.Nil(s.T(), cliGet("", &GitLoader{}, "", s.loading, getOptions{}))
+	assert.Nil(s.T(), cliGet("major", &GitLoader{}, "", s.loading, getOptions{}))
+}
+
+func (s *CLIGetTestSuite) Test_cliGet_output() {
+	assert.Nil(s.T(), cliGet("", &GitLoader{}, "", s.loading, getOptions{output: "json"}))
+	assert.Panics(s.T(), func() { cliGet("", &GitLoader{}, "", s.loading, getOptions{output: "xml"}) })
+}
+
+func (s *CLIGetTestSuite) Test_cliGet_help() {
+	assert.EqualError(s.T(), cliGet("help", &GitLoader{}, "", s.loading, getOptions{}), "help requested")
+}
+
+func (s *CLIGetTestSuite) Test_cliGet_format() {
+	assert.Nil(s.T(), cliGet("", &GitLoader{}, "", s.loading, getOptions{format: "docker-tags"}))
+	assert.Panics(s.T(), func() { cliGet("", &GitLoader{}, "", s.loading, getOptions{format: "{{.Major"}) })
+	assert.Panics(s.T(), func() { cliGet("", &GitLoader{}, "", s.loading, getOptions{output: "json", format: "msi"}) })
+}
+
+func (s *CLIGetTestSuite) Test_cliGet_build() {
+	assert.Nil(s.T(), cliGet("build", &GitLoader{}, "", s.loading, getOptions{build: "sha.{{.SHA}}"}))
+	assert.Nil(s.T(), cliGet("", &GitLoader{}, "", s.loading, getOptions{build: "{{.Commits}}", output: "json"}))
+	assert.Panics(s.T(), func() { cliGet("", &GitLoader{}, "", s.loading, getOptions{build: "build_1"}) })
+}
+
+func (s *CLIGetTestSuite) Test_cliGet_dev() {
+	s.commit("second commit")
+	assert.Nil(s.T(), cliGet("", &GitLoader{}, "", s.loading, getOptions{dev: true}))
+	assert.Panics(s.T(), func() { cliGet("", &GitLoader{}, "", s.loading, getOptions{dev: true, build: "1"}) })
+}
+
+func (s *CLIGetTestSuite) Test_cliGet_maxSatisfying() {
+	s.git("tag", "2.0.0")
+	assert.Nil(s.T(), cliGet("", &GitLoader{}, "", s.loading, getOptions{maxSatisfying: "^1.0.0"}))
+	assert.Panics(s.T(), func() { cliGet("", &GitLoader{}, "", s.loading, getOptions{maxSatisfying: "^3.0.0"}) })
+}
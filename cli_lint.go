@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli"
+)
+
+type CLILint func(string, lintOptions) error
+
+func cliLint(command string, options lintOptions) error {
+	if command == "help" {
+		return fmt.Errorf("help requested")
+	}
+	issues, err := lintTags(options)
+	if err != nil {
+		panic(err)
+	}
+	if len(issues) == 0 {
+		fmt.Println("no issues found")
+		return nil
+	}
+	for _, issue := range issues {
+		fmt.Println(issue)
+	}
+	panic(&causedError{ErrLintIssues, fmt.Sprintf("%d issues found", len(issues))})
+}
+
+func getLintCommand() cli.Command {
+	return cli.Command{
+		Action: func(c *cli.Context) {
+			handleLint(c, cliLint)
+		},
+		Description: "audits the tags of the repository, reporting tags with mixed prefixes (mixed-prefix), tags which look like versions but are not valid semver (not-semver), skipped versions (skipped-version), versions tagged on different commits (duplicate-version), prereleases tagged after their release (late-prerelease) and versions not reachable from the main branch (unreachable). exits with code 10 if any issues are found",
+		Flags:       flags(flagConfig, flagMainBranch),
+		Name:        "lint",
+		Usage:       "audits the version tags of the repository",
+	}
+}
+
+func handleLint(c *cli.Context, lint CLILint) error {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println(r)
+			os.Exit(exitCodeFor(r))
+		}
+	}()
+	if _, err := applyConfig(c); err != nil {
+		panic(err)
+	}
+	command := c.Args().First()
+	options := lintOptions{
+		mainBranch: c.String("main-branch"),
+	}
+
+	if err := lint(command, options); err != nil {
+		cli.ShowSubcommandHelp(c)
+		return err
+	}
+	return nil
+}
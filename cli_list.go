@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli"
+)
+
+type CLIList func(string, VersionSource, string, componentOptions, listOptions) error
+
+func cliList(section string, source VersionSource, prefix string, components componentOptions, listing listOptions) error {
+	switch section {
+	case "":
+		return listVersions(source, prefix, listing)
+	case "components":
+		return listComponents(components)
+	default:
+		return fmt.Errorf("help requested")
+	}
+}
+
+// listVersions prints the versions of the :source selected by the
+// :listing options in ascending order of precedence
+func listVersions(source VersionSource, prefix string, listing listOptions) error {
+	versions, err := source.List(prefix)
+	if err != nil {
+		panic(err)
+	}
+	if versions, err = listing.filter(versions, prefix); err != nil {
+		panic(err)
+	}
+	listed, err := newListedVersions(versions, source, listing.details)
+	if err != nil {
+		panic(err)
+	}
+	output, err := renderList(listed, listing.output, listing.details)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(output)
+	return nil
+}
+
+// listComponents prints the components of the monorepo along with their
+// paths and the tags of their latest versions
+func listComponents(options componentOptions) error {
+	components, versions, err := options.list()
+	if err != nil {
+		panic(err)
+	}
+	table := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "COMPONENT\tPATH\tLATEST TAG")
+	for _, component := range components {
+		version := "-"
+		if latest, ok := versions[component.name]; ok {
+			version = latest.String()
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\n", component.name, component.path, version)
+	}
+	return table.Flush()
+}
+
+func getListCommand() cli.Command {
+	return cli.Command{
+		Action: func(c *cli.Context) {
+			handleList(c, cliList)
+		},
+		Aliases:     []string{"l"},
+		ArgsUsage:   "<< components >>",
+		Description: "lists the semver versions in ascending order of precedence. use --since, --stable, --prereleases and --limit to select the versions listed, and --details to include the commit and date of their tags. use 'components' to list the components of a monorepo, which are those in the configuration file and those with tags matching --tag-pattern, along with their latest versions",
+		Flags:       flags(flagConfig, flagUse, flagUsePath, flagPrefix, flagComponent, flagTagPattern, flagSince, flagStable, flagPrereleases, flagLimit, flagDetails, flagListOutput),
+		Name:        "list",
+		Usage:       "lists the repository's semver tags",
+	}
+}
+
+func handleList(c *cli.Context, list CLIList) error {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println(r)
+			os.Exit(exitCodeFor(r))
+		}
+	}()
+	configuration, err := applyConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	section := strings.ToLower(c.Args().First())
+	source, err := getVersionSource(c)
+	if err != nil {
+		panic(err)
+	}
+	components := getComponentOptions(c, configuration)
+	prefix, err := components.prefix(strings.ToLower(c.String("prefix")))
+	if err != nil {
+		panic(err)
+	}
+	listing := getListOptions(c)
+
+	if err := list(section, source, prefix, components, listing); err != nil {
+		cli.ShowSubcommandHelp(c)
+		return err
+	}
+	return nil
+}
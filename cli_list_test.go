@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CLIListTestSuite struct {
+	GitRepositoryTestSuite
+	components componentOptions
+}
+
+func TestCLIList(t *testing.T) {
+	suite.Run(t, new(CLIListTestSuite))
+}
+
+func (s *CLIListTestSuite) SetupTest() {
+	s.GitRepositoryTestSuite.SetupTest()
+	s.components = componentOptions{pattern: defaultTagPattern}
+	s.commit("initial commit")
+	s.git("tag", "v1.0.0")
+	s.git("tag", "api/v1.0.0")
+}
+
+func (s *CLIListTestSuite) Test_cliList() {
+	assert.Nil(s.T(), cliList("", &GitLoader{}, "v", s.components, listOptions{}))
+	assert.Nil(s.T(), cliList("", &GitLoader{}, "v", s.components, listOptions{details: true, output: "json"}))
+	assert.Nil(s.T(), cliList("components", &GitLoader{}, "v", s.components, listOptions{}))
+	assert.EqualError(s.T(), cliList("help", &GitLoader{}, "v", s.components, listOptions{}), "help requested")
+}
+
+func (s *CLIListTestSuite) Test_cliList_invalid() {
+	assert.Panics(s.T(), func() { cliList("", &GitLoader{}, "v", s.components, listOptions{stable: true, prereleases: true}) })
+	assert.Panics(s.T(), func() { cliList("", &GitLoader{}, "v", s.components, listOptions{output: "xml"}) })
+}
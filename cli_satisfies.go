@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
+)
+
+type CLISatisfies func(string, string, string) error
+
+func cliSatisfies(version string, constraint string, prefix string) error {
+	if version == "help" || version == "" || constraint == "" {
+		return fmt.Errorf("help requested")
+	}
+	parsedVersion, err := parseVersionArgument(version, prefix)
+	if err != nil {
+		panic(&causedError{ErrInvalidVersion, fmt.Sprintf("invalid semver '%s' specified: %s", version, err)})
+	}
+	parsedConstraint, err := semver.ParseConstraint(constraint, prefix)
+	if err != nil {
+		panic(&causedError{ErrInvalidVersion, err.Error()})
+	}
+	if !parsedConstraint.Check(parsedVersion) {
+		panic(&causedError{ErrNotSatisfied, fmt.Sprintf("%s does not satisfy '%s'", parsedVersion, constraint)})
+	}
+	fmt.Printf("%s satisfies '%s'\n", parsedVersion, constraint)
+	return nil
+}
+
+// maxSatisfying returns the highest version from the :source which
+// satisfies the :constraint
+func maxSatisfying(source VersionSource, prefix string, constraint string) (*semver.Semver, error) {
+	parsedConstraint, err := semver.ParseConstraint(constraint, prefix)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := source.List(prefix)
+	if err != nil {
+		return nil, err
+	}
+	highest := semver.MaxSatisfying(versions, parsedConstraint)
+	if highest == nil {
+		return nil, &causedError{ErrNoTags, fmt.Sprintf("no versions satisfy '%s'", constraint)}
+	}
+	return semver.NewFrom(toSemverLoader(highest))
+}
+
+func getSatisfiesCommand() cli.Command {
+	return cli.Command{
+		Action: func(c *cli.Context) {
+			handleSatisfies(c, cliSatisfies)
+		},
+		ArgsUsage:   "<< version >> << constraint >>",
+		Description: "checks whether the version satisfies the constraint, such as '>=1.4.0 <2.0.0', '^1.4.0', '~1.4', '1.x', '1.4.0 - 1.8.0' or a union of them separated by '||'. exits with code 6 if it does not, and 9 if the version or constraint is invalid",
+		Flags:       flags(flagConfig, flagPrefix),
+		Name:        "satisfies",
+		Usage:       "checks a version against a constraint",
+	}
+}
+
+func handleSatisfies(c *cli.Context, satisfies CLISatisfies) error {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println(r)
+			os.Exit(exitCodeFor(r))
+		}
+	}()
+	if _, err := applyConfig(c); err != nil {
+		panic(err)
+	}
+	version := c.Args().First()
+	constraint := c.Args().Get(1)
+	prefix := c.String("prefix")
+
+	if err := satisfies(version, constraint, prefix); err != nil {
+		cli.ShowSubcommandHelp(c)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CLISatisfiesTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestCLISatisfies(t *testing.T) {
+	suite.Run(t, new(CLISatisfiesTestSuite))
+}
+
+func (s *CLISatisfiesTestSuite) Test_cliSatisfies() {
+	assert.Nil(s.T(), cliSatisfies("1.4.2", ">=1.4.0 <2.0.0", ""))
+	assert.Nil(s.T(), cliSatisfies("v1.4.2", "^v1.4.0", "v"))
+	assert.Nil(s.T(), cliSatisfies("1.4.2", "^1.4.0", "v"))
+	assert.EqualError(s.T(), cliSatisfies("help", "", ""), "help requested")
+	assert.EqualError(s.T(), cliSatisfies("1.4.2", "", ""), "help requested")
+}
+
+func (s *CLISatisfiesTestSuite) Test_cliSatisfies_notSatisfied() {
+	defer func() {
+		err := recover().(error)
+		assert.Equal(s.T(), ErrNotSatisfied, errorCause(err))
+		assert.EqualError(s.T(), err, "2.0.0 does not satisfy '>=1.4.0 <2.0.0'")
+	}()
+	cliSatisfies("2.0.0", ">=1.4.0 <2.0.0", "")
+}
+
+func (s *CLISatisfiesTestSuite) Test_cliSatisfies_invalid() {
+	for _, args := range [][]string{{"1.4", "^1.0.0"}, {"1.4.0", "^1.0.0.0"}} {
+		func() {
+			defer func() {
+				assert.Equal(s.T(), ErrInvalidVersion, errorCause(recover().(error)))
+			}()
+			cliSatisfies(args[0], args[1], "")
+		}()
+	}
+}
+
+func (s *CLISatisfiesTestSuite) Test_cliSatisfies_caseSensitive() {
+	defer func() {
+		err := recover().(error)
+		assert.Equal(s.T(), ErrNotSatisfied, errorCause(err))
+		assert.EqualError(s.T(), err, "1.0.0-RC.1 does not satisfy '>=1.0.0-alpha.1'")
+	}()
+	cliSatisfies("1.0.0-RC.1", ">=1.0.0-alpha.1", "")
+}
+
+func (s *CLISatisfiesTestSuite) Test_handleSatisfies() {
+	var version, constraint, prefix string
+	c := newTestContext(flags(flagConfig, flagPrefix), "--prefix", "V", "V1.0.0-RC.1", ">=V1.0.0-alpha.1")
+	assert.Nil(s.T(), handleSatisfies(c, func(satisfiedVersion string, satisfiedConstraint string, satisfiedPrefix string) error {
+		version, constraint, prefix = satisfiedVersion, satisfiedConstraint, satisfiedPrefix
+		return nil
+	}))
+	assert.Equal(s.T(), "V1.0.0-RC.1", version)
+	assert.Equal(s.T(), ">=V1.0.0-alpha.1", constraint)
+	assert.Equal(s.T(), "V", prefix)
+}
+
+func (s *CLISatisfiesTestSuite) Test_maxSatisfying() {
+	s.commit("initial commit")
+	for _, tag := range []string{"v1.2.0", "v1.4.0", "v1.10.0-rc.0", "v2.0.0"} {
+		s.git("tag", tag)
+	}
+	version, err := maxSatisfying(&GitLoader{}, "v", "^1.2.0")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "v1.4.0", version.String())
+	_, err = maxSatisfying(&GitLoader{}, "v", ">=3.0.0")
+	assert.Equal(s.T(), ErrNoTags, errorCause(err))
+	assert.EqualError(s.T(), err, "no versions satisfy '>=3.0.0'")
+	_, err = maxSatisfying(&GitLoader{}, "v", ">=3.0.0.0")
+	assert.EqualError(s.T(), err, "invalid constraint '>=3.0.0.0': invalid version '3.0.0.0'")
+}
@@ -1,30 +1,52 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
-	"strings"
+	"io"
+	"os"
 
 	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
 )
 
-type CLISet func(string, string) error
+type CLISet func(string, VersionSource, string, bool, bool, releaseOptions) error
 
-func cliSet(version string, prefix string) error {
-	if version == "help" {
+func cliSet(version string, source VersionSource, prefix string, force bool, ciMode bool, releasing releaseOptions) error {
+	if version == "help" || version == "" {
 		return fmt.Errorf("help requested")
 	}
-	if isSemverLike(version) {
-		semver := toSemver(version, prefix)
-		fmt.Println("setting version to:")
-		fmt.Printf("  prefix : %s\n", semver.GetPrefix())
-		fmt.Printf("  major  : %v\n", semver.GetMajorInt())
-		fmt.Printf("  minor  : %v\n", semver.GetMinorInt())
-		fmt.Printf("  patch  : %v\n", semver.GetPatchInt())
-		fmt.Printf("  label  : %s\n", semver.GetLabel())
-		fmt.Printf("  --------\n")
-		fmt.Printf("  %s\n", semver)
-	} else {
-		fmt.Println("invalid semver '" + version + "' specified")
+	target, err := parseVersionArgument(version, prefix)
+	if err != nil {
+		panic(fmt.Errorf("invalid semver '%s' specified: %s", version, err))
+	}
+	fmt.Println("setting version to:")
+	fmt.Printf("  prefix : %s\n", target.GetPrefix())
+	fmt.Printf("  major  : %v\n", target.GetMajorInt())
+	fmt.Printf("  minor  : %v\n", target.GetMinorInt())
+	fmt.Printf("  patch  : %v\n", target.GetPatchInt())
+	fmt.Printf("  label  : %s\n", target.GetLabel())
+	fmt.Printf("  build  : %s\n", target.GetBuild())
+	fmt.Printf("  --------\n")
+	fmt.Printf("  %s\n", target)
+
+	currentSemver := "nothing"
+	if latest, err := semver.NewFrom(source.Load("latest", prefix)); err == nil {
+		currentSemver = latest.String()
+		if semver.Compare(target, latest) <= 0 && !force {
+			panic(fmt.Errorf("refusing to set version to %s which is not higher than the latest version %s (use --force to override)", target, latest))
+		}
+	} else if errorCause(err) != ErrNoTags {
+		panic(err)
+	}
+	confirmed := ciMode || releasing.dryRun
+	if !confirmed {
+		confirmed = setConfirm(os.Stdin, currentSemver, target.String())
+	}
+	if confirmed {
+		if err := releasing.release(source, target, versionChange{"set", currentSemver, target.String()}, ""); err != nil {
+			panic(err)
+		}
 	}
 	return nil
 }
@@ -36,20 +58,52 @@ func getSetCommand() cli.Command {
 		},
 		Aliases:     []string{"s"},
 		ArgsUsage:   "<< version to set >>",
-		Description: "sets the version of the application under development to a specific version of your choice",
-		Flags:       flags(flagPrefix),
+		Description: "sets the version of the application under development to a specific version of your choice. versions lower than or equal to the latest version are refused unless --force is specified",
+		Flags:       flags(flagConfig, flagUse, flagUsePath, flagPrefix, flagComponent, flagTagPattern, flagForce, flagYes, flagAnnotate, flagMessage, flagSign, flagSignKey, flagPush, flagRollback, flagFile, flagDryRun, flagBranch),
 		Name:        "set",
 		Usage:       "explicitly sets the version",
 	}
 }
 
 func handleSet(c *cli.Context, set CLISet) error {
-	version := strings.ToLower(c.Args().First())
-	prefix := strings.ToLower(c.String("prefix"))
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println(r)
+			os.Exit(exitCodeFor(r))
+		}
+	}()
+	configuration, err := applyConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	version := c.Args().First()
+	prefix, err := getComponentOptions(c, configuration).prefix(c.String("prefix"))
+	if err != nil {
+		panic(err)
+	}
+	force := c.Bool("force")
+	yes := c.Bool("yes")
+	releasing := getReleaseOptions(c, configuration)
+	source, err := getVersionSource(c)
+	if err != nil {
+		panic(err)
+	}
 
-	if err := set(version, prefix); err != nil {
+	if err := set(version, source, prefix, force, yes, releasing); err != nil {
 		cli.ShowSubcommandHelp(c)
 		return err
 	}
 	return nil
 }
+
+func setConfirm(via io.Reader, preSet string, postSet string) bool {
+	return confirm(
+		bufio.NewReader(via),
+		fmt.Sprintf(
+			"set the version (%s -> %s)? ",
+			preSet,
+			postSet,
+		),
+		false,
+	)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CLISetTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestCLISet(t *testing.T) {
+	suite.Run(t, new(CLISetTestSuite))
+}
+
+func (s *CLISetTestSuite) Test_cliSet_createsTag() {
+	s.commit("initial commit")
+	assert.Nil(s.T(), cliSet("1.2.3", &GitLoader{}, "", false, true, releaseOptions{}))
+	assert.Equal(s.T(), "1.2.3", s.git("tag", "--points-at", "HEAD"))
+}
+
+func (s *CLISetTestSuite) Test_cliSet_withPrefix() {
+	s.commit("initial commit")
+	s.git("tag", "v1.0.0")
+	assert.Nil(s.T(), cliSet("1.1.0", &GitLoader{}, "v", false, true, releaseOptions{}))
+	assert.Contains(s.T(), s.git("tag", "--list"), "v1.1.0")
+}
+
+func (s *CLISetTestSuite) Test_cliSet_refusesLowerOrEqualVersion() {
+	s.commit("initial commit")
+	s.git("tag", "2.0.0")
+	assert.Panics(s.T(), func() { cliSet("1.9.9", &GitLoader{}, "", false, true, releaseOptions{}) })
+	assert.Panics(s.T(), func() { cliSet("2.0.0-rc.1", &GitLoader{}, "", false, true, releaseOptions{}) })
+	assert.Equal(s.T(), "2.0.0", s.git("tag", "--list"))
+}
+
+func (s *CLISetTestSuite) Test_cliSet_forcesLowerVersion() {
+	s.commit("initial commit")
+	s.git("tag", "2.0.0")
+	assert.Nil(s.T(), cliSet("1.9.9", &GitLoader{}, "", true, true, releaseOptions{}))
+	assert.Contains(s.T(), s.git("tag", "--list"), "1.9.9")
+}
+
+func (s *CLISetTestSuite) Test_cliSet_invalidVersion() {
+	s.commit("initial commit")
+	assert.Panics(s.T(), func() { cliSet("1.2", &GitLoader{}, "", false, true, releaseOptions{}) })
+	assert.Empty(s.T(), s.git("tag", "--list"))
+}
+
+func (s *CLISetTestSuite) Test_handleSet() {
+	s.commit("initial commit")
+	assert.Nil(s.T(), handleSet(newTestContext(getSetCommand().Flags, "--yes", "1.0.0-RC.1"), cliSet))
+	assert.Equal(s.T(), "1.0.0-RC.1", s.git("tag", "--points-at", "HEAD"))
+	assert.Nil(s.T(), handleSet(newTestContext(getSetCommand().Flags, "--yes", "--prefix", "V", "V1.0.0"), cliSet))
+	assert.Equal(s.T(), "1.0.0-RC.1\nV1.0.0", s.git("tag", "--points-at", "HEAD"))
+}
+
+func (s *CLISetTestSuite) Test_cliSet_help() {
+	assert.EqualError(s.T(), cliSet("help", &GitLoader{}, "", false, true, releaseOptions{}), "help requested")
+}
+
+func (s *CLISetTestSuite) Test_cliSet_withFiles() {
+	s.commit("initial commit")
+	ioutil.WriteFile("VERSION", []byte("0.1.0\n"), 0644)
+	s.git("add", "VERSION")
+	s.commit("add version file")
+	assert.Nil(s.T(), cliSet("1.0.0", &GitLoader{}, "", false, true, releaseOptions{files: []string{"VERSION"}}))
+	assert.Equal(s.T(), "chore(release): 1.0.0", s.git("log", "-1", "--format=%s"))
+	assert.Equal(s.T(), "1.0.0", s.git("show", "1.0.0:VERSION"))
+}
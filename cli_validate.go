@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli"
+)
+
+type CLIValidate func(string, string) error
+
+func cliValidate(version string, prefix string) error {
+	if version == "help" || version == "" {
+		return fmt.Errorf("help requested")
+	}
+	parsedVersion, err := parseVersionArgument(version, prefix)
+	if err != nil {
+		panic(&causedError{ErrInvalidVersion, err.Error()})
+	}
+	fmt.Printf("%s is a valid semver version\n", parsedVersion)
+	return nil
+}
+
+func getValidateCommand() cli.Command {
+	return cli.Command{
+		Action: func(c *cli.Context) {
+			handleValidate(c, cliValidate)
+		},
+		ArgsUsage:   "<< version >>",
+		Description: "checks whether the version is a valid semver version as defined by the SemVer 2.0.0 specification, with or without the prefix. exits with code 9 if it is not",
+		Flags:       flags(flagConfig, flagPrefix),
+		Name:        "validate",
+		Usage:       "checks whether a version is valid semver",
+	}
+}
+
+func handleValidate(c *cli.Context, validate CLIValidate) error {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println(r)
+			os.Exit(exitCodeFor(r))
+		}
+	}()
+	if _, err := applyConfig(c); err != nil {
+		panic(err)
+	}
+	version := c.Args().First()
+	prefix := c.String("prefix")
+
+	if err := validate(version, prefix); err != nil {
+		cli.ShowSubcommandHelp(c)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CLIValidateTestSuite struct {
+	suite.Suite
+}
+
+func TestCLIValidate(t *testing.T) {
+	suite.Run(t, new(CLIValidateTestSuite))
+}
+
+func (s *CLIValidateTestSuite) Test_cliValidate() {
+	assert.Nil(s.T(), cliValidate("1.2.3-rc.1+build.5", ""))
+	assert.Nil(s.T(), cliValidate("v1.2.3", "v"))
+	assert.Nil(s.T(), cliValidate("1.2.3", "v"))
+	assert.EqualError(s.T(), cliValidate("help", ""), "help requested")
+	assert.EqualError(s.T(), cliValidate("", ""), "help requested")
+}
+
+func (s *CLIValidateTestSuite) Test_cliValidate_invalid() {
+	defer func() {
+		err := recover().(error)
+		assert.Equal(s.T(), ErrInvalidVersion, errorCause(err))
+		assert.EqualError(s.T(), err, "invalid semver '01.2.3': major version '01' has a leading zero")
+	}()
+	cliValidate("01.2.3", "")
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
+)
+
+// defaultTagPattern is the pattern of the tags of components when no
+// pattern is specified
+const defaultTagPattern = "{component}/v{version}"
+
+// tagPattern is the pattern of the tags of the components of a monorepo,
+// where {component} is replaced by the path of the component and
+// {version} by its version, eg. 'services/api/v1.2.3' for the pattern
+// '{component}/v{version}'
+type tagPattern string
+
+// validate returns an error if the pattern does not contain {component}
+// exactly once or does not end with {version}
+func (pattern tagPattern) validate() error {
+	if strings.Count(string(pattern), "{component}") != 1 || strings.Count(string(pattern), "{version}") != 1 || !strings.HasSuffix(string(pattern), "{version}") {
+		return fmt.Errorf("invalid tag pattern '%s' specified, expected it to contain {component} and end with {version}", pattern)
+	}
+	return nil
+}
+
+// prefix returns the prefix of the versions of the component at the :path
+func (pattern tagPattern) prefix(path string) string {
+	return strings.Replace(strings.TrimSuffix(string(pattern), "{version}"), "{component}", path, 1)
+}
+
+// regexp returns a regular expression matching tags of the pattern, with
+// the path of the component and the version as its submatches
+func (pattern tagPattern) regexp() *regexp.Regexp {
+	parts := strings.SplitN(strings.TrimSuffix(string(pattern), "{version}"), "{component}", 2)
+	return regexp.MustCompile("^" + regexp.QuoteMeta(parts[0]) + "(.+)" + regexp.QuoteMeta(parts[1]) + "(.+)$")
+}
+
+// component is a part of a monorepo which is versioned on its own
+type component struct {
+	// name identifies the component for --component
+	name string
+	// path is the directory of the component relative to the root of the
+	// repository
+	path string
+}
+
+// componentOptions defines the component of a monorepo being versioned
+type componentOptions struct {
+	// name is the name of the component, the repository is versioned as a
+	// whole if it is empty
+	name string
+	// pattern is the tag pattern of the components
+	pattern tagPattern
+	// components maps the names of the components to their paths, components
+	// not listed are found at the path of their name
+	components map[string]string
+}
+
+// getComponentOptions retrieves the component options from the flags of a
+// command and the components of the :configuration
+func getComponentOptions(c *cli.Context, configuration config) componentOptions {
+	return componentOptions{
+		name:       c.String("component"),
+		pattern:    tagPattern(c.String("tag-pattern")),
+		components: configuration.Components,
+	}
+}
+
+// component returns the component being versioned
+func (options componentOptions) component() component {
+	if path, ok := options.components[options.name]; ok {
+		return component{options.name, strings.Trim(path, "/")}
+	}
+	return component{options.name, strings.Trim(options.name, "/")}
+}
+
+// prefix returns the prefix of the versions of the component being
+// versioned, or the :prefix if there is none
+func (options componentOptions) prefix(prefix string) (string, error) {
+	if len(options.name) == 0 {
+		return prefix, nil
+	}
+	if err := options.pattern.validate(); err != nil {
+		return "", err
+	}
+	return options.pattern.prefix(options.component().path), nil
+}
+
+// paths returns the pathspecs of the commits which change the component
+// being versioned, or none if the repository is versioned as a whole
+func (options componentOptions) paths() []string {
+	if len(options.name) == 0 {
+		return nil
+	}
+	return []string{":(top)" + options.component().path}
+}
+
+// list returns the configured components and those with tags matching the
+// tag pattern, along with their latest versions, sorted by name
+func (options componentOptions) list() ([]component, map[string]semver.ISemver, error) {
+	if err := options.pattern.validate(); err != nil {
+		return nil, nil, err
+	}
+	names := map[string]string{}
+	for name, path := range options.components {
+		names[strings.Trim(path, "/")] = name
+	}
+	tags, err := gitTagList()
+	if err != nil {
+		return nil, nil, err
+	}
+	versions := map[string]semver.ISemver{}
+	pattern := options.pattern.regexp()
+	for _, tag := range splitLines(tags) {
+		match := pattern.FindStringSubmatch(tag)
+		if match == nil {
+			continue
+		}
+		version, err := semver.Parse(tag, options.pattern.prefix(match[1]))
+		if err != nil {
+			continue
+		}
+		if _, ok := names[match[1]]; !ok {
+			names[match[1]] = match[1]
+		}
+		if latest, ok := versions[names[match[1]]]; !ok || semver.Compare(version, latest) > 0 {
+			versions[names[match[1]]] = version
+		}
+	}
+	var components []component
+	for path, name := range names {
+		components = append(components, component{name, path})
+	}
+	sort.Slice(components, func(i, j int) bool { return components[i].name < components[j].name })
+	return components, versions, nil
+}
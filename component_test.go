@@ -0,0 +1,88 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ComponentTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestComponent(t *testing.T) {
+	suite.Run(t, new(ComponentTestSuite))
+}
+
+func (s *ComponentTestSuite) Test_tagPattern_validate() {
+	assert.Nil(s.T(), tagPattern(defaultTagPattern).validate())
+	assert.Nil(s.T(), tagPattern("{component}@{version}").validate())
+	for _, pattern := range []string{"v{version}", "{component}/v", "{version}/{component}", "{component}/{component}/{version}"} {
+		assert.EqualError(s.T(), tagPattern(pattern).validate(), "invalid tag pattern '"+pattern+"' specified, expected it to contain {component} and end with {version}")
+	}
+}
+
+func (s *ComponentTestSuite) Test_tagPattern_prefix() {
+	assert.Equal(s.T(), "services/api/v", tagPattern(defaultTagPattern).prefix("services/api"))
+	assert.Equal(s.T(), "auth@", tagPattern("{component}@{version}").prefix("auth"))
+}
+
+func (s *ComponentTestSuite) Test_tagPattern_regexp() {
+	match := tagPattern(defaultTagPattern).regexp().FindStringSubmatch("services/api/v1.2.3")
+	assert.Equal(s.T(), []string{"services/api/v1.2.3", "services/api", "1.2.3"}, match)
+	assert.Nil(s.T(), tagPattern(defaultTagPattern).regexp().FindStringSubmatch("v1.2.3"))
+}
+
+func (s *ComponentTestSuite) Test_componentOptions_prefix() {
+	options := componentOptions{pattern: defaultTagPattern, components: map[string]string{"api": "services/api/"}}
+	prefix, err := options.prefix("v")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "v", prefix)
+	assert.Nil(s.T(), options.paths())
+	options.name = "api"
+	prefix, err = options.prefix("v")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "services/api/v", prefix)
+	assert.Equal(s.T(), []string{":(top)services/api"}, options.paths())
+	options.name = "libs/auth"
+	prefix, err = options.prefix("v")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "libs/auth/v", prefix)
+	options.pattern = "v{version}"
+	_, err = options.prefix("v")
+	assert.NotNil(s.T(), err)
+}
+
+func (s *ComponentTestSuite) Test_componentOptions_list() {
+	s.commit("initial commit")
+	for _, tag := range []string{"services/api/v1.2.3", "services/api/v1.10.0", "libs/auth/v0.4.0", "libs/auth/vnext", "v2.0.0"} {
+		s.git("tag", tag)
+	}
+	options := componentOptions{pattern: defaultTagPattern, components: map[string]string{"api": "services/api", "web": "apps/web"}}
+	components, versions, err := options.list()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []component{{"api", "services/api"}, {"libs/auth", "libs/auth"}, {"web", "apps/web"}}, components)
+	assert.Len(s.T(), versions, 2)
+	assert.Equal(s.T(), "services/api/v1.10.0", versions["api"].String())
+	assert.Equal(s.T(), "libs/auth/v0.4.0", versions["libs/auth"].String())
+}
+
+func (s *ComponentTestSuite) Test_componentRelease() {
+	s.commit("feat: initial feature")
+	os.Mkdir("api", 0755)
+	ioutil.WriteFile("api/CHANGELOG.md", []byte("# Changelog\n"), 0644)
+	s.git("add", "api")
+	s.commit("fix(api): a bug")
+	s.commit("feat(web): a feature")
+	loading := loadOptions{mode: "latest", initial: "0.0.0"}
+	bumping := bumpOptions{rules: defaultBumpRules, channels: defaultChannels, changelog: "api/CHANGELOG.md"}
+	releasing := releaseOptions{paths: []string{":(top)api"}}
+	assert.Nil(s.T(), cliBump("auto", true, &GitLoader{}, "api/v", loading, bumping, releasing))
+	assert.Equal(s.T(), "api/v0.0.1", s.git("tag", "--list", "api/*"))
+	changelog := s.git("show", "HEAD:api/CHANGELOG.md")
+	assert.Contains(s.T(), changelog, "a bug")
+	assert.NotContains(s.T(), changelog, "a feature")
+}
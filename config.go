@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/urfave/cli"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// configFileNames lists the names of the configuration files discovered
+// from the root of the repository, in order of preference
+var configFileNames = []string{".gosemver.yaml", ".gosemver.yml", ".gosemver.toml"}
+
+// config holds the settings of a project configuration file. settings are
+// only used for flags which were not specified on the command line or via
+// an environment variable
+type config struct {
+	// Prefix is the value for --prefix
+	Prefix string `yaml:"prefix,omitempty" toml:"prefix,omitempty"`
+	// Source is the value for --use
+	Source string `yaml:"source,omitempty" toml:"source,omitempty"`
+	// SourcePath is the value for --use-path
+	SourcePath string `yaml:"source-path,omitempty" toml:"source-path,omitempty"`
+	// Mode is the value for --mode
+	Mode string `yaml:"mode,omitempty" toml:"mode,omitempty"`
+	// Initial is the value for --initial
+	Initial string `yaml:"initial,omitempty" toml:"initial,omitempty"`
+	// Annotate is the value for --annotate
+	Annotate bool `yaml:"annotate,omitempty" toml:"annotate,omitempty"`
+	// Message is the value for --message
+	Message string `yaml:"message,omitempty" toml:"message,omitempty"`
+	// Sign is the value for --sign
+	Sign bool `yaml:"sign,omitempty" toml:"sign,omitempty"`
+	// SignKey is the value for --sign-key
+	SignKey string `yaml:"sign-key,omitempty" toml:"sign-key,omitempty"`
+	// Push is the value for --push
+	Push string `yaml:"push,omitempty" toml:"push,omitempty"`
+	// Files are the values for --file
+	Files []string `yaml:"files,omitempty" toml:"files,omitempty"`
+	// Rules maps commit types to the section they bump, see --rules
+	Rules map[string]string `yaml:"rules,omitempty" toml:"rules,omitempty"`
+	// Channels are the values for --channels
+	Channels []string `yaml:"channels,omitempty" toml:"channels,omitempty"`
+	// Changelog is the value for --changelog
+	Changelog string `yaml:"changelog,omitempty" toml:"changelog,omitempty"`
+	// Branches are the branches releases can be made from, releases can be
+	// made from any branch if there are none
+	Branches []branchPolicy `yaml:"branches,omitempty" toml:"branches,omitempty"`
+	// TagPattern is the value for --tag-pattern
+	TagPattern string `yaml:"tag-pattern,omitempty" toml:"tag-pattern,omitempty"`
+	// Components maps the names of the components of a monorepo to their
+	// paths, see --component
+	Components map[string]string `yaml:"components,omitempty" toml:"components,omitempty"`
+}
+
+// branchPolicy defines the releases which can be made from a branch
+type branchPolicy struct {
+	// Name is the name of the branch and may be a glob (eg. 'release/*')
+	Name string `yaml:"name" toml:"name"`
+	// Bumps are the bump types allowed on the branch, one of bumpTypes or
+	// 'set'. all are allowed if empty
+	Bumps []string `yaml:"bumps,omitempty" toml:"bumps,omitempty"`
+}
+
+// findConfig returns the path to the configuration file in the root of the
+// repository, or in the current directory outside of a repository. an
+// empty path is returned if there is none
+func findConfig() (string, error) {
+	root, err := gitTopLevel()
+	if err != nil {
+		if root, err = os.Getwd(); err != nil {
+			return "", err
+		}
+	}
+	for _, name := range configFileNames {
+		configPath := filepath.Join(root, name)
+		if _, err := os.Stat(configPath); err == nil {
+			return configPath, nil
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+// readConfig reads the configuration file at :configPath as TOML if it has
+// a .toml extension, or as YAML otherwise. unknown settings are an error
+func readConfig(configPath string) (config, error) {
+	var configuration config
+	if strings.ToLower(filepath.Ext(configPath)) == ".toml" {
+		metadata, err := toml.DecodeFile(configPath, &configuration)
+		if err != nil {
+			return config{}, fmt.Errorf("invalid config file %s: %s", configPath, err)
+		}
+		if undecoded := metadata.Undecoded(); len(undecoded) > 0 {
+			return config{}, fmt.Errorf("invalid config file %s: unknown setting '%s'", configPath, undecoded[0])
+		}
+	} else {
+		contents, err := ioutil.ReadFile(configPath)
+		if err != nil {
+			return config{}, err
+		}
+		if err := yaml.UnmarshalStrict(contents, &configuration); err != nil {
+			return config{}, fmt.Errorf("invalid config file %s: %s", configPath, err)
+		}
+	}
+	return configuration, configuration.validate(configPath)
+}
+
+// validate returns an error if the configuration read from :configPath
+// has invalid branch policies or an invalid tag pattern
+func (configuration config) validate(configPath string) error {
+	if len(configuration.TagPattern) > 0 {
+		if err := tagPattern(configuration.TagPattern).validate(); err != nil {
+			return fmt.Errorf("invalid config file %s: %s", configPath, err)
+		}
+	}
+	for _, policy := range configuration.Branches {
+		if _, err := path.Match(policy.Name, ""); err != nil || len(policy.Name) == 0 {
+			return fmt.Errorf("invalid config file %s: invalid branch name '%s'", configPath, policy.Name)
+		}
+		for _, bumpType := range policy.Bumps {
+			if !sliceContainsString(append([]string{"set"}, bumpTypes...), bumpType) {
+				return fmt.Errorf("invalid config file %s: invalid bump type '%s' for branch '%s'", configPath, bumpType, policy.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// settings returns the values of the flags set by the configuration
+func (configuration config) settings() map[string][]string {
+	settings := map[string][]string{}
+	values := map[string]string{
+		"prefix":      configuration.Prefix,
+		"use":         configuration.Source,
+		"use-path":    configuration.SourcePath,
+		"mode":        configuration.Mode,
+		"initial":     configuration.Initial,
+		"message":     configuration.Message,
+		"sign-key":    configuration.SignKey,
+		"push":        configuration.Push,
+		"changelog":   configuration.Changelog,
+		"tag-pattern": configuration.TagPattern,
+	}
+	for name, value := range values {
+		if len(value) > 0 {
+			settings[name] = []string{value}
+		}
+	}
+	if configuration.Annotate {
+		settings["annotate"] = []string{"true"}
+	}
+	if configuration.Sign {
+		settings["sign"] = []string{"true"}
+	}
+	if len(configuration.Files) > 0 {
+		settings["file"] = configuration.Files
+	}
+	if len(configuration.Channels) > 0 {
+		settings["channels"] = []string{strings.Join(configuration.Channels, ",")}
+	}
+	if len(configuration.Rules) > 0 {
+		settings["rules"] = []string{formatBumpRules(configuration.Rules)}
+	}
+	return settings
+}
+
+// applyConfig sets the flags of the command which were neither specified
+// on the command line nor via an environment variable to the values in the
+// configuration file, returning the configuration
+func applyConfig(c *cli.Context) (config, error) {
+	configPath := c.String("config")
+	if len(configPath) == 0 {
+		var err error
+		if configPath, err = findConfig(); err != nil || len(configPath) == 0 {
+			return config{}, err
+		}
+	}
+	configuration, err := readConfig(configPath)
+	if err != nil {
+		return config{}, err
+	}
+	flagNames := c.FlagNames()
+	for name, values := range configuration.settings() {
+		if !sliceContainsString(flagNames, name) || c.IsSet(name) {
+			continue
+		}
+		for _, value := range values {
+			if err := c.Set(name, value); err != nil {
+				return config{}, fmt.Errorf("invalid value '%s' for '%s' in config file %s: %s", value, name, configPath, err)
+			}
+		}
+	}
+	return configuration, nil
+}
+
+// formatBumpRules formats the :rules as expected by --rules
+func formatBumpRules(rules map[string]string) string {
+	var pairs []string
+	for commitType, level := range rules {
+		pairs = append(pairs, commitType+"="+level)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/urfave/cli"
+)
+
+type ConfigTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestConfig(t *testing.T) {
+	suite.Run(t, new(ConfigTestSuite))
+}
+
+// write creates the file at :path with the :contents
+func (s *ConfigTestSuite) write(path string, contents string) {
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		panic(err)
+	}
+}
+
+// newTestContext creates the context of a command with the :commandFlags,
+// parsing the :args as its command line
+func newTestContext(commandFlags []cli.Flag, args ...string) *cli.Context {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	for _, commandFlag := range commandFlags {
+		commandFlag.Apply(set)
+	}
+	if err := set.Parse(args); err != nil {
+		panic(err)
+	}
+	c := cli.NewContext(cli.NewApp(), set, nil)
+	c.Command = cli.Command{Name: "test", Flags: commandFlags}
+	return c
+}
+
+func (s *ConfigTestSuite) Test_findConfig() {
+	configPath, err := findConfig()
+	assert.Nil(s.T(), err)
+	assert.Empty(s.T(), configPath)
+	s.write(".gosemver.toml", "prefix = 'v'\n")
+	s.write(".gosemver.yml", "prefix: v\n")
+	os.Mkdir("subdirectory", 0755)
+	os.Chdir("subdirectory")
+	configPath, err = findConfig()
+	assert.Nil(s.T(), err)
+	root, _ := filepath.EvalSymlinks(s.repositoryDirectory)
+	assert.Equal(s.T(), filepath.Join(root, ".gosemver.yml"), configPath)
+}
+
+func (s *ConfigTestSuite) Test_readConfig() {
+	s.write(".gosemver.yaml", `prefix: v
+source: npm
+message: "{{.BumpType}} release {{.Next}}"
+files:
+  - package.json
+  - chart/Chart.yaml:appVersion
+rules:
+  feat: minor
+  docs: patch
+branches:
+  - name: main
+  - name: release/*
+    bumps: [patch]
+`)
+	s.write(".gosemver.toml", `prefix = "v"
+source = "npm"
+message = "{{.BumpType}} release {{.Next}}"
+files = ["package.json", "chart/Chart.yaml:appVersion"]
+
+[rules]
+feat = "minor"
+docs = "patch"
+
+[[branches]]
+name = "main"
+
+[[branches]]
+name = "release/*"
+bumps = ["patch"]
+`)
+	expected := config{
+		Prefix:  "v",
+		Source:  "npm",
+		Message: "{{.BumpType}} release {{.Next}}",
+		Files:   []string{"package.json", "chart/Chart.yaml:appVersion"},
+		Rules:   map[string]string{"feat": "minor", "docs": "patch"},
+		Branches: []branchPolicy{
+			{Name: "main"},
+			{Name: "release/*", Bumps: []string{"patch"}},
+		},
+	}
+	for _, configPath := range []string{".gosemver.yaml", ".gosemver.toml"} {
+		configuration, err := readConfig(configPath)
+		assert.Nil(s.T(), err, configPath)
+		assert.Equal(s.T(), expected, configuration, configPath)
+	}
+}
+
+func (s *ConfigTestSuite) Test_readConfig_invalid() {
+	s.write(".gosemver.yaml", "prefx: v\n")
+	_, err := readConfig(".gosemver.yaml")
+	assert.Contains(s.T(), err.Error(), "invalid config file .gosemver.yaml")
+	s.write(".gosemver.toml", "prefx = 'v'\n")
+	_, err = readConfig(".gosemver.toml")
+	assert.EqualError(s.T(), err, "invalid config file .gosemver.toml: unknown setting 'prefx'")
+	s.write(".gosemver.yaml", "branches:\n  - name: main\n    bumps: [huge]\n")
+	_, err = readConfig(".gosemver.yaml")
+	assert.EqualError(s.T(), err, "invalid config file .gosemver.yaml: invalid bump type 'huge' for branch 'main'")
+	s.write(".gosemver.yaml", "tag-pattern: v{version}\n")
+	_, err = readConfig(".gosemver.yaml")
+	assert.EqualError(s.T(), err, "invalid config file .gosemver.yaml: invalid tag pattern 'v{version}' specified, expected it to contain {component} and end with {version}")
+}
+
+func (s *ConfigTestSuite) Test_applyConfig() {
+	s.write(".gosemver.yaml", "prefix: v\nsource: npm\nmode: current\nannotate: true\nfiles: [package.json, Dockerfile]\nrules: {feat: minor}\n")
+	os.Setenv("GOSEMVER_USE", "cargo")
+	defer os.Unsetenv("GOSEMVER_USE")
+	c := newTestContext(flags(flagConfig, flagPrefix, flagUse, flagMode, flagInitial, flagAnnotate, flagFile, flagRules), "--mode", "head")
+	configuration, err := applyConfig(c)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "npm", configuration.Source)
+	assert.Equal(s.T(), "v", c.String("prefix"))
+	assert.Equal(s.T(), "cargo", c.String("use"))
+	assert.Equal(s.T(), "head", c.String("mode"))
+	assert.Equal(s.T(), "0.0.0", c.String("initial"))
+	assert.True(s.T(), c.Bool("annotate"))
+	assert.Equal(s.T(), []string{"package.json", "Dockerfile"}, c.StringSlice("file"))
+	assert.Equal(s.T(), "feat=minor", c.String("rules"))
+}
+
+func (s *ConfigTestSuite) Test_applyConfig_explicitPath() {
+	s.write("custom.toml", "prefix = 'v'\n")
+	c := newTestContext(flags(flagConfig, flagPrefix), "--config", "custom.toml")
+	_, err := applyConfig(c)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "v", c.String("prefix"))
+}
+
+func (s *ConfigTestSuite) Test_effectiveConfig() {
+	c := newTestContext(flags(flagPrefix, flagUse, flagMode, flagRules, flagFile), "--prefix", "v", "--file", "VERSION")
+	effective, err := effectiveConfig(c, config{Branches: []branchPolicy{{Name: "main"}}})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), config{
+		Prefix:   "v",
+		Source:   "git",
+		Mode:     "latest",
+		Files:    []string{"VERSION"},
+		Rules:    map[string]string{"feat": "minor", "fix": "patch", "perf": "patch"},
+		Branches: []branchPolicy{{Name: "main"}},
+	}, effective)
+}
+
+func (s *ConfigTestSuite) Test_cliConfig() {
+	assert.Nil(s.T(), cliConfig("", config{Prefix: "v"}))
+	assert.EqualError(s.T(), cliConfig("help", config{}), "help requested")
+}
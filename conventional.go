@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// defaultBumpRules maps Conventional Commit types to the version section
+// they bump when no rules are specified
+const defaultBumpRules = "feat=minor,fix=patch,perf=patch"
+
+// conventionalCommitHeader matches the first line of a Conventional Commit,
+// eg. "feat(parser)!: add support for build metadata"
+var conventionalCommitHeader = regexp.MustCompile(`^([a-zA-Z]+)(\(([^()]*)\))?(!)?: (.+)$`)
+
+// conventionalCommitBreakingFooter matches the footer indicating a breaking
+// change within the body of a Conventional Commit
+var conventionalCommitBreakingFooter = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE: `)
+
+// bumpLevels lists the sections which can be bumped in ascending order of
+// significance
+var bumpLevels = []string{"none", "patch", "minor", "major"}
+
+// conventionalCommit holds the parsed sections of a Conventional Commit
+type conventionalCommit struct {
+	Type        string
+	Scope       string
+	Description string
+	Body        string
+	Breaking    bool
+}
+
+// parseConventionalCommit parses the commit :message, returning false if it
+// does not follow the Conventional Commits specification
+func parseConventionalCommit(message string) (conventionalCommit, bool) {
+	lines := strings.SplitN(trimAndNormalise(message), "\n", 2)
+	matches := conventionalCommitHeader.FindStringSubmatch(lines[0])
+	if matches == nil {
+		return conventionalCommit{}, false
+	}
+	commit := conventionalCommit{
+		Type:        strings.ToLower(matches[1]),
+		Scope:       matches[3],
+		Description: matches[5],
+		Breaking:    len(matches[4]) > 0,
+	}
+	if len(lines) > 1 {
+		commit.Body = trimAndNormalise(lines[1])
+		commit.Breaking = commit.Breaking || conventionalCommitBreakingFooter.MatchString(commit.Body)
+	}
+	return commit, true
+}
+
+// bumpRules maps Conventional Commit types to the section they bump
+type bumpRules map[string]string
+
+// parseBumpRules parses a comma-separated list of type=section pairs such
+// as "feat=minor,fix=patch"
+func parseBumpRules(rules string) (bumpRules, error) {
+	parsedRules := bumpRules{}
+	for _, rule := range removeEmptyStringsFromStringSlice(strings.Split(rules, ",")) {
+		pair := strings.SplitN(rule, "=", 2)
+		if len(pair) != 2 || len(strings.TrimSpace(pair[0])) == 0 {
+			return nil, fmt.Errorf("invalid bump rule '%s', expected <type>=<section>", rule)
+		}
+		commitType := strings.ToLower(strings.TrimSpace(pair[0]))
+		level := strings.ToLower(strings.TrimSpace(pair[1]))
+		if !sliceContainsString(bumpLevels, level) {
+			return nil, fmt.Errorf("invalid section '%s' in bump rule '%s', expected one of %s", level, rule, strings.Join(bumpLevels, ", "))
+		}
+		parsedRules[commitType] = level
+	}
+	return parsedRules, nil
+}
+
+// levelFor returns the section bumped by the :commit
+func (rules bumpRules) levelFor(commit conventionalCommit) string {
+	if commit.Breaking {
+		return "major"
+	}
+	if level, ok := rules[commit.Type]; ok {
+		return level
+	}
+	return "none"
+}
+
+// detect returns the most significant section bumped by any of the commit
+// :messages, or "none" if there are no releasable changes
+func (rules bumpRules) detect(messages []string) string {
+	detected := "none"
+	for _, message := range messages {
+		commit, ok := parseConventionalCommit(message)
+		if !ok {
+			continue
+		}
+		if level := rules.levelFor(commit); bumpLevelRank(level) > bumpLevelRank(detected) {
+			detected = level
+		}
+	}
+	return detected
+}
+
+// detectBumpType determines the section to bump from the Conventional
+// Commits made since the latest semver tag using the bump :rules, only
+// considering commits changing any of the :paths if specified
+func detectBumpType(prefix string, rules string, paths ...string) (string, error) {
+	parsedRules, err := parseBumpRules(rules)
+	if err != nil {
+		return "", err
+	}
+	loader := GitLoader{}
+	latest, err := loader.getLatest(prefix)
+	if err != nil {
+		return "", err
+	}
+	revisionRange := "HEAD"
+	if latest != nil {
+		revisionRange = latest.String() + "..HEAD"
+	}
+	messages, err := gitLog(revisionRange, paths...)
+	if err != nil {
+		return "", err
+	}
+	if level := parsedRules.detect(messages); level != "none" {
+		return level, nil
+	}
+	return "", ErrNoReleasableChanges
+}
+
+// bumpLevelRank returns the significance of the section :level
+func bumpLevelRank(level string) int {
+	for rank, bumpLevel := range bumpLevels {
+		if bumpLevel == level {
+			return rank
+		}
+	}
+	return 0
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConventionalTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestConventional(t *testing.T) {
+	suite.Run(t, new(ConventionalTestSuite))
+}
+
+func (s *ConventionalTestSuite) Test_parseConventionalCommit() {
+	commit, ok := parseConventionalCommit("feat(parser): add build metadata\n\nsome details")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), conventionalCommit{
+		Type:        "feat",
+		Scope:       "parser",
+		Description: "add build metadata",
+		Body:        "some details",
+	}, commit)
+	commit, ok = parseConventionalCommit("fix!: drop support for labels")
+	assert.True(s.T(), ok)
+	assert.True(s.T(), commit.Breaking)
+	commit, ok = parseConventionalCommit("refactor: rewrite\n\nBREAKING CHANGE: the api has changed")
+	assert.True(s.T(), ok)
+	assert.True(s.T(), commit.Breaking)
+	commit, ok = parseConventionalCommit("docs: mention BREAKING CHANGE: inline")
+	assert.True(s.T(), ok)
+	assert.False(s.T(), commit.Breaking)
+	_, ok = parseConventionalCommit("Merge branch 'feature'")
+	assert.False(s.T(), ok)
+	_, ok = parseConventionalCommit("feat:missing space")
+	assert.False(s.T(), ok)
+}
+
+func (s *ConventionalTestSuite) Test_parseBumpRules() {
+	rules, err := parseBumpRules(defaultBumpRules)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), bumpRules{"feat": "minor", "fix": "patch", "perf": "patch"}, rules)
+	rules, err = parseBumpRules("Feat = Major, docs=none")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), bumpRules{"feat": "major", "docs": "none"}, rules)
+	_, err = parseBumpRules("feat")
+	assert.NotNil(s.T(), err)
+	_, err = parseBumpRules("feat=huge")
+	assert.NotNil(s.T(), err)
+}
+
+func (s *ConventionalTestSuite) Test_bumpRules_detect() {
+	rules, _ := parseBumpRules(defaultBumpRules)
+	assert.Equal(s.T(), "none", rules.detect([]string{"chore: tidy", "not conventional"}))
+	assert.Equal(s.T(), "patch", rules.detect([]string{"chore: tidy", "fix: a bug"}))
+	assert.Equal(s.T(), "minor", rules.detect([]string{"fix: a bug", "feat: a feature", "perf: faster"}))
+	assert.Equal(s.T(), "major", rules.detect([]string{"feat: a feature", "chore!: drop go 1.10"}))
+}
+
+func (s *ConventionalTestSuite) Test_detectBumpType() {
+	s.commit("feat: initial feature")
+	s.git("tag", "v1.0.0")
+	s.commit("fix: a bug")
+	s.commit("docs: a document")
+	level, err := detectBumpType("v", defaultBumpRules)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "patch", level)
+	level, err = detectBumpType("v", "docs=minor")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "minor", level)
+}
+
+func (s *ConventionalTestSuite) Test_detectBumpType_paths() {
+	s.commit("feat: initial feature")
+	s.git("tag", "api/v1.0.0")
+	os.Mkdir("api", 0755)
+	ioutil.WriteFile("api/main.go", []byte("package main\n"), 0644)
+	s.git("add", "api")
+	s.commit("fix(api): a bug")
+	s.commit("feat(auth): a feature")
+	level, err := detectBumpType("api/v", defaultBumpRules, ":(top)api")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "patch", level)
+	_, err = detectBumpType("api/v", defaultBumpRules, ":(top)auth")
+	assert.Equal(s.T(), ErrNoReleasableChanges, err)
+}
+
+func (s *ConventionalTestSuite) Test_detectBumpType_withoutTags() {
+	s.commit("feat: initial feature")
+	s.commit("chore: tidy")
+	level, err := detectBumpType("", defaultBumpRules)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "minor", level)
+}
+
+func (s *ConventionalTestSuite) Test_detectBumpType_noReleasableChanges() {
+	s.commit("feat: initial feature")
+	s.git("tag", "1.0.0")
+	s.commit("chore: tidy")
+	_, err := detectBumpType("", defaultBumpRules)
+	assert.Equal(s.T(), ErrNoReleasableChanges, err)
+}
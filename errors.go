@@ -0,0 +1,128 @@
+package main
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNotARepository is returned when git is run outside of a repository
+var ErrNotARepository = errors.New("not a git repository")
+
+// ErrTagExists is returned when the tag to be created already exists
+var ErrTagExists = errors.New("tag already exists")
+
+// ErrNoTags is returned when no suitable tags could be found
+var ErrNoTags = errors.New("no tags found")
+
+// ErrNoReleasableChanges is returned when no commits warrant a new version
+var ErrNoReleasableChanges = errors.New("no releasable changes found")
+
+// ErrNotSatisfied is returned when a version does not satisfy a constraint
+var ErrNotSatisfied = errors.New("version does not satisfy the constraint")
+
+// ErrVersionLower is returned when a version is lower than the version it
+// is compared with
+var ErrVersionLower = errors.New("version is lower")
+
+// ErrVersionHigher is returned when a version is higher than the version it
+// is compared with
+var ErrVersionHigher = errors.New("version is higher")
+
+// ErrInvalidVersion is returned when a version being validated is invalid
+var ErrInvalidVersion = errors.New("invalid version")
+
+// ErrLintIssues is returned when linting the tags found issues
+var ErrLintIssues = errors.New("issues found")
+
+const (
+	// exitCodeError is the exit code for any error without a specific code
+	exitCodeError = 1
+	// exitCodeNotARepository is the exit code for ErrNotARepository
+	exitCodeNotARepository = 2
+	// exitCodeTagExists is the exit code for ErrTagExists
+	exitCodeTagExists = 3
+	// exitCodeNoTags is the exit code for ErrNoTags
+	exitCodeNoTags = 4
+	// exitCodeNoReleasableChanges is the exit code for ErrNoReleasableChanges
+	exitCodeNoReleasableChanges = 5
+	// exitCodeNotSatisfied is the exit code for ErrNotSatisfied
+	exitCodeNotSatisfied = 6
+	// exitCodeVersionLower is the exit code for ErrVersionLower
+	exitCodeVersionLower = 7
+	// exitCodeVersionHigher is the exit code for ErrVersionHigher
+	exitCodeVersionHigher = 8
+	// exitCodeInvalidVersion is the exit code for ErrInvalidVersion
+	exitCodeInvalidVersion = 9
+	// exitCodeLintIssues is the exit code for ErrLintIssues
+	exitCodeLintIssues = 10
+)
+
+// causedError associates a descriptive message with one of the Err* errors
+type causedError struct {
+	cause   error
+	message string
+}
+
+// Error implements the error interface
+func (err *causedError) Error() string {
+	if len(err.message) == 0 {
+		return err.cause.Error()
+	}
+	return err.message
+}
+
+// errorCause returns the Err* error underlying :err, or :err itself if it
+// has no underlying cause
+func errorCause(err error) error {
+	if withCause, ok := err.(*causedError); ok {
+		return withCause.cause
+	}
+	return err
+}
+
+// exitCodeFor returns the exit code for a value recovered from a panic
+func exitCodeFor(recovered interface{}) int {
+	err, ok := recovered.(error)
+	if !ok {
+		return exitCodeError
+	}
+	switch errorCause(err) {
+	case ErrNotARepository:
+		return exitCodeNotARepository
+	case ErrTagExists:
+		return exitCodeTagExists
+	case ErrNoTags:
+		return exitCodeNoTags
+	case ErrNoReleasableChanges:
+		return exitCodeNoReleasableChanges
+	case ErrNotSatisfied:
+		return exitCodeNotSatisfied
+	case ErrVersionLower:
+		return exitCodeVersionLower
+	case ErrVersionHigher:
+		return exitCodeVersionHigher
+	case ErrInvalidVersion:
+		return exitCodeInvalidVersion
+	case ErrLintIssues:
+		return exitCodeLintIssues
+	}
+	return exitCodeError
+}
+
+// toGitError converts the standard error output of a failed git command
+// into one of the Err* errors where it is recognised
+func toGitError(errorOutput string, err error) error {
+	if len(errorOutput) == 0 {
+		errorOutput = err.Error()
+	}
+	switch {
+	case strings.Contains(errorOutput, "not a git repository"):
+		return &causedError{ErrNotARepository, errorOutput}
+	case strings.Contains(errorOutput, "already exists"):
+		return &causedError{ErrTagExists, errorOutput}
+	case strings.Contains(errorOutput, "No names found"),
+		strings.Contains(errorOutput, "No tags can describe"):
+		return &causedError{ErrNoTags, errorOutput}
+	}
+	return errors.New(errorOutput)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ErrorsTestSuite struct {
+	suite.Suite
+}
+
+func TestErrors(t *testing.T) {
+	suite.Run(t, new(ErrorsTestSuite))
+}
+
+func (s *ErrorsTestSuite) Test_exitCodeFor() {
+	assert.Equal(s.T(), exitCodeNotARepository, exitCodeFor(&causedError{ErrNotARepository, "fatal"}))
+	assert.Equal(s.T(), exitCodeTagExists, exitCodeFor(&causedError{ErrTagExists, "fatal"}))
+	assert.Equal(s.T(), exitCodeNoTags, exitCodeFor(&causedError{ErrNoTags, "fatal"}))
+	assert.Equal(s.T(), exitCodeNoTags, exitCodeFor(ErrNoTags))
+	assert.Equal(s.T(), exitCodeNoReleasableChanges, exitCodeFor(ErrNoReleasableChanges))
+	assert.Equal(s.T(), exitCodeNotSatisfied, exitCodeFor(&causedError{ErrNotSatisfied, "1.0.0 does not satisfy '^2.0.0'"}))
+	assert.Equal(s.T(), exitCodeVersionLower, exitCodeFor(&causedError{ErrVersionLower, "1.0.0 is lower than 2.0.0"}))
+	assert.Equal(s.T(), exitCodeVersionHigher, exitCodeFor(&causedError{ErrVersionHigher, "2.0.0 is higher than 1.0.0"}))
+	assert.Equal(s.T(), exitCodeInvalidVersion, exitCodeFor(&causedError{ErrInvalidVersion, "invalid semver '1.2'"}))
+	assert.Equal(s.T(), exitCodeLintIssues, exitCodeFor(&causedError{ErrLintIssues, "2 issues found"}))
+	assert.Equal(s.T(), exitCodeError, exitCodeFor(errors.New("other")))
+	assert.Equal(s.T(), exitCodeError, exitCodeFor("not an error"))
+}
+
+func (s *ErrorsTestSuite) Test_causedError() {
+	assert.EqualError(s.T(), &causedError{ErrNoTags, ""}, "no tags found")
+	assert.EqualError(s.T(), &causedError{ErrNoTags, "no semver tags point at HEAD"}, "no semver tags point at HEAD")
+}
+
+func (s *ErrorsTestSuite) Test_toGitError() {
+	testCases := map[string]error{
+		"fatal: not a git repository (or any of the parent directories): .git": ErrNotARepository,
+		"fatal: tag '1.0.0' already exists":                                    ErrTagExists,
+		"fatal: No names found, cannot describe anything.":                     ErrNoTags,
+		"fatal: No tags can describe 'abc123'.":                                ErrNoTags,
+	}
+	for errorOutput, expected := range testCases {
+		err := toGitError(errorOutput, errors.New("exit status 128"))
+		assert.Equal(s.T(), expected, errorCause(err))
+		assert.EqualError(s.T(), err, errorOutput)
+	}
+	err := toGitError("fatal: something else", errors.New("exit status 128"))
+	assert.EqualError(s.T(), err, "fatal: something else")
+	assert.Equal(s.T(), err, errorCause(err))
+	assert.EqualError(s.T(), toGitError("", errors.New("exit status 1")), "exit status 1")
+}
@@ -4,19 +4,28 @@ import "github.com/urfave/cli"
 
 func flagUse() cli.Flag {
 	return cli.StringFlag{
-		Usage:  "currently it's only 'git'",
+		Usage:  "the source of versions, one of 'git', 'file' (VERSION), 'npm' (package.json), 'cargo' (Cargo.toml), 'pyproject' (pyproject.toml), 'helm' (Chart.yaml) or 'go' (version.go)",
 		Name:   "use, u",
 		Value:  "git",
-		EnvVar: "USE",
+		EnvVar: "GOSEMVER_USE",
+	}
+}
+
+func flagUsePath() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "the path to the file holding the version for file-based sources, defaults to the conventional file name of the source",
+		Name:   "use-path",
+		Value:  "",
+		EnvVar: "GOSEMVER_USE_PATH",
 	}
 }
 
 func flagMode() cli.Flag {
 	return cli.StringFlag{
-		Usage:  "one of 'latest' or 'current': 'latest' gets the latest version, 'current' gets the most recently tagged semver version",
+		Usage:  "one of 'latest', 'current', 'head' or 'reachable': 'latest' gets the highest semver tag, 'current' gets the most recently tagged semver version, 'head' gets the highest semver tag pointing at HEAD, 'reachable' gets the highest semver tag reachable from HEAD",
 		Name:   "mode, m",
 		Value:  "latest",
-		EnvVar: "MODE",
+		EnvVar: "GOSEMVER_MODE",
 	}
 }
 
@@ -25,7 +34,7 @@ func flagPrefix() cli.Flag {
 		Usage:  "set this to 'v' if your versions are prefixed with a 'v' (eg. v1.0.0)",
 		Name:   "prefix, p",
 		Value:  "",
-		EnvVar: "PREFIX",
+		EnvVar: "GOSEMVER_PREFIX",
 	}
 }
 
@@ -33,6 +42,300 @@ func flagYes() cli.Flag {
 	return cli.BoolFlag{
 		Usage:  "specify this to say yes to any questions asked in interactive mode",
 		Name:   "yes, y",
-		EnvVar: "YES",
+		EnvVar: "GOSEMVER_YES",
+	}
+}
+
+func flagForce() cli.Flag {
+	return cli.BoolFlag{
+		Usage:  "specify this to set a version even if it is not higher than the latest version",
+		Name:   "force, f",
+		EnvVar: "GOSEMVER_FORCE",
+	}
+}
+
+func flagAnnotate() cli.Flag {
+	return cli.BoolFlag{
+		Usage:  "specify this to create an annotated tag instead of a lightweight tag",
+		Name:   "annotate, a",
+		EnvVar: "GOSEMVER_ANNOTATE",
+	}
+}
+
+func flagMessage() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "template for the annotated tag message, with {{.Previous}}, {{.Next}} and {{.BumpType}} available (implies --annotate)",
+		Name:   "message, M",
+		Value:  "",
+		EnvVar: "GOSEMVER_MESSAGE",
+	}
+}
+
+func flagSign() cli.Flag {
+	return cli.BoolFlag{
+		Usage:  "specify this to create a signed tag using the default signing key (implies --annotate)",
+		Name:   "sign, s",
+		EnvVar: "GOSEMVER_SIGN",
+	}
+}
+
+func flagSignKey() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "the GPG key ID or SSH key to sign the tag with (implies --sign)",
+		Name:   "sign-key, k",
+		Value:  "",
+		EnvVar: "GOSEMVER_SIGN_KEY",
+	}
+}
+
+func flagPush() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "the remote to push the newly created tag to (eg. 'origin')",
+		Name:   "push, P",
+		Value:  "",
+		EnvVar: "GOSEMVER_PUSH",
+	}
+}
+
+func flagRollback() cli.Flag {
+	return cli.BoolFlag{
+		Usage:  "specify this to delete the newly created tag if pushing it fails",
+		Name:   "rollback, r",
+		EnvVar: "GOSEMVER_ROLLBACK",
+	}
+}
+
+func flagInitial() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "the version to use when the repository has no semver tags",
+		Name:   "initial, i",
+		Value:  "0.0.0",
+		EnvVar: "GOSEMVER_INITIAL",
+	}
+}
+
+func flagStrict() cli.Flag {
+	return cli.BoolFlag{
+		Usage:  "specify this to fail instead of using the initial version when the repository has no semver tags",
+		Name:   "strict",
+		EnvVar: "GOSEMVER_STRICT",
+	}
+}
+
+func flagRules() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "comma-separated <type>=<section> pairs mapping Conventional Commit types to the section they bump when using 'auto', where section is one of 'major', 'minor', 'patch' or 'none'",
+		Name:   "rules, R",
+		Value:  defaultBumpRules,
+		EnvVar: "GOSEMVER_RULES",
+	}
+}
+
+func flagFrom() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "the ref to start from (exclusive), defaults to the highest semver tag lower than --to",
+		Name:   "from",
+		Value:  "",
+		EnvVar: "GOSEMVER_CHANGELOG_FROM",
+	}
+}
+
+func flagTo() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "the ref to end at (inclusive), a version tag sets the prefix if --prefix is not specified",
+		Name:   "to",
+		Value:  "HEAD",
+		EnvVar: "GOSEMVER_CHANGELOG_TO",
+	}
+}
+
+func flagChangelog() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "the file to prepend the changelog entry to (eg. 'CHANGELOG.md')",
+		Name:   "changelog, c",
+		Value:  "",
+		EnvVar: "GOSEMVER_CHANGELOG",
+	}
+}
+
+func flagFile() cli.Flag {
+	return cli.StringSliceFlag{
+		Usage:  "a file to update the version in before releasing, as '<path>', '<path>=<regex>' or '<path>:<key.path>' (can be specified multiple times)",
+		Name:   "file, F",
+		EnvVar: "GOSEMVER_FILE",
+	}
+}
+
+func flagDryRun() cli.Flag {
+	return cli.BoolFlag{
+		Usage:  "prints the changes which would be made instead of making them",
+		Name:   "dry-run, n",
+		EnvVar: "GOSEMVER_DRY_RUN",
+	}
+}
+
+func flagConfig() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "the path to the configuration file, defaults to the .gosemver.yaml, .gosemver.yml or .gosemver.toml in the root of the repository",
+		Name:   "config, C",
+		Value:  "",
+		EnvVar: "GOSEMVER_CONFIG",
+	}
+}
+
+func flagBranch() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "the branch to check the branch policies of the configuration file against, defaults to the current branch",
+		Name:   "branch",
+		Value:  "",
+		EnvVar: "GOSEMVER_BRANCH",
+	}
+}
+
+func flagOutput() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "prints all fields of the version in the format, one of 'json', 'yaml' or 'env'",
+		Name:   "output, o",
+		Value:  "",
+		EnvVar: "GOSEMVER_OUTPUT",
+	}
+}
+
+func flagFormat() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "prints the version using a Go template (eg. '{{.Major}}.{{.Minor}}') or one of the presets 'docker-tags', 'msi', 'pep440' or 'maven'",
+		Name:   "format",
+		Value:  "",
+		EnvVar: "GOSEMVER_FORMAT",
+	}
+}
+
+func flagChannels() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "comma-separated prerelease channels in ascending order of maturity, prereleases can only be promoted to a later channel",
+		Name:   "channels",
+		Value:  defaultChannels,
+		EnvVar: "GOSEMVER_CHANNELS",
+	}
+}
+
+func flagBuild() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "sets the build metadata using a Go template with the tokens {{.SHA}}, {{.Commits}} (since the latest version), {{.Timestamp}} (UTC) and {{.BuildNumber}} (of the CI service), eg. 'build.{{.BuildNumber}}.sha.{{.SHA}}'",
+		Name:   "build",
+		Value:  "",
+		EnvVar: "GOSEMVER_BUILD",
+	}
+}
+
+func flagDev() cli.Flag {
+	return cli.BoolFlag{
+		Usage:  "specify this to print the development version of HEAD, made up of the next patch version, the number of commits since the version and the commit SHA (eg. 1.4.1-dev.7+g9f2c1e), with '.dirty' appended if the worktree has changes",
+		Name:   "dev",
+		EnvVar: "GOSEMVER_DEV",
+	}
+}
+
+func flagComponent() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "specify this to version a component of a monorepo on its own, using the tags matching --tag-pattern for the component and only the commits changing its directory",
+		Name:   "component",
+		Value:  "",
+		EnvVar: "GOSEMVER_COMPONENT",
+	}
+}
+
+func flagTagPattern() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "pattern of the tags of the components of a monorepo, where {component} is replaced by the path of the component and {version} by its version",
+		Name:   "tag-pattern",
+		Value:  defaultTagPattern,
+		EnvVar: "GOSEMVER_TAG_PATTERN",
+	}
+}
+
+func flagSince() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "specify this to only list versions higher than or equal to the version",
+		Name:   "since",
+		Value:  "",
+		EnvVar: "GOSEMVER_SINCE",
+	}
+}
+
+func flagStable() cli.Flag {
+	return cli.BoolFlag{
+		Usage:  "specify this to only list versions without a prerelease",
+		Name:   "stable",
+		EnvVar: "GOSEMVER_STABLE",
+	}
+}
+
+func flagPrereleases() cli.Flag {
+	return cli.BoolFlag{
+		Usage:  "specify this to only list prereleases",
+		Name:   "prereleases",
+		EnvVar: "GOSEMVER_PRERELEASES",
+	}
+}
+
+func flagLimit() cli.Flag {
+	return cli.IntFlag{
+		Usage:  "specify this to only list the given number of highest versions",
+		Name:   "limit",
+		Value:  0,
+		EnvVar: "GOSEMVER_LIMIT",
+	}
+}
+
+func flagDetails() cli.Flag {
+	return cli.BoolFlag{
+		Usage:  "specify this to include the commit and date of the tag of each version",
+		Name:   "details",
+		EnvVar: "GOSEMVER_DETAILS",
+	}
+}
+
+func flagListOutput() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "prints the versions in the format, one of 'table' or 'json'",
+		Name:   "output, o",
+		Value:  "table",
+		EnvVar: "GOSEMVER_LIST_OUTPUT",
+	}
+}
+
+func flagMaxSatisfying() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "specify this to retrieve the highest version satisfying the constraint (eg. '^1.4.0' or '>=1.4.0 <2.0.0') instead of the version selected by --mode",
+		Name:   "max-satisfying",
+		Value:  "",
+		EnvVar: "GOSEMVER_MAX_SATISFYING",
+	}
+}
+
+func flagExitCode() cli.Flag {
+	return cli.BoolFlag{
+		Usage:  "specify this to exit with code 0 if the versions are of equal precedence, 7 if the first is lower and 8 if it is higher instead of printing the result",
+		Name:   "exit-code",
+		EnvVar: "GOSEMVER_EXIT_CODE",
+	}
+}
+
+func flagDiffOutput() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "prints the change in the format, one of 'text' or 'json'",
+		Name:   "output, o",
+		Value:  "text",
+		EnvVar: "GOSEMVER_DIFF_OUTPUT",
+	}
+}
+
+func flagMainBranch() cli.Flag {
+	return cli.StringFlag{
+		Usage:  "specify the branch all versions should be reachable from, defaults to the default branch of the remote 'origin', or 'main' or 'master'",
+		Name:   "main-branch",
+		Value:  "",
+		EnvVar: "GOSEMVER_MAIN_BRANCH",
 	}
 }
@@ -28,7 +28,15 @@ func (s *CLIFlagsTestSuite) Test_flagUse() {
 	assert.NotNil(s.T(), flag.Usage)
 	assert.Equal(s.T(), "use, u", flag.Name)
 	assert.Equal(s.T(), "git", flag.Value)
-	assert.Equal(s.T(), "USE", flag.EnvVar)
+	assert.Equal(s.T(), "GOSEMVER_USE", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagUsePath() {
+	flag := cli.StringFlag(flagUsePath().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "use-path", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_USE_PATH", flag.EnvVar)
 }
 
 func (s *CLIFlagsTestSuite) Test_flagMode() {
@@ -36,7 +44,7 @@ func (s *CLIFlagsTestSuite) Test_flagMode() {
 	assert.NotNil(s.T(), flag.Usage)
 	assert.Equal(s.T(), "mode, m", flag.Name)
 	assert.Equal(s.T(), "latest", flag.Value)
-	assert.Equal(s.T(), "MODE", flag.EnvVar)
+	assert.Equal(s.T(), "GOSEMVER_MODE", flag.EnvVar)
 }
 
 func (s *CLIFlagsTestSuite) Test_flagPrefix() {
@@ -44,12 +52,273 @@ func (s *CLIFlagsTestSuite) Test_flagPrefix() {
 	assert.NotNil(s.T(), flag.Usage)
 	assert.Equal(s.T(), "prefix, p", flag.Name)
 	assert.Equal(s.T(), "", flag.Value)
-	assert.Equal(s.T(), "PREFIX", flag.EnvVar)
+	assert.Equal(s.T(), "GOSEMVER_PREFIX", flag.EnvVar)
 }
 
 func (s *CLIFlagsTestSuite) Test_flagYes() {
 	flag := cli.BoolFlag(flagYes().(cli.BoolFlag))
 	assert.NotNil(s.T(), flag.Usage)
 	assert.Equal(s.T(), "yes, y", flag.Name)
-	assert.Equal(s.T(), "YES", flag.EnvVar)
+	assert.Equal(s.T(), "GOSEMVER_YES", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagForce() {
+	flag := cli.BoolFlag(flagForce().(cli.BoolFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "force, f", flag.Name)
+	assert.Equal(s.T(), "GOSEMVER_FORCE", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagAnnotate() {
+	flag := cli.BoolFlag(flagAnnotate().(cli.BoolFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "annotate, a", flag.Name)
+	assert.Equal(s.T(), "GOSEMVER_ANNOTATE", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagMessage() {
+	flag := cli.StringFlag(flagMessage().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "message, M", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_MESSAGE", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagSign() {
+	flag := cli.BoolFlag(flagSign().(cli.BoolFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "sign, s", flag.Name)
+	assert.Equal(s.T(), "GOSEMVER_SIGN", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagSignKey() {
+	flag := cli.StringFlag(flagSignKey().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "sign-key, k", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_SIGN_KEY", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagPush() {
+	flag := cli.StringFlag(flagPush().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "push, P", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_PUSH", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagRollback() {
+	flag := cli.BoolFlag(flagRollback().(cli.BoolFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "rollback, r", flag.Name)
+	assert.Equal(s.T(), "GOSEMVER_ROLLBACK", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagInitial() {
+	flag := cli.StringFlag(flagInitial().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "initial, i", flag.Name)
+	assert.Equal(s.T(), "0.0.0", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_INITIAL", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagStrict() {
+	flag := cli.BoolFlag(flagStrict().(cli.BoolFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "strict", flag.Name)
+	assert.Equal(s.T(), "GOSEMVER_STRICT", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagRules() {
+	flag := cli.StringFlag(flagRules().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "rules, R", flag.Name)
+	assert.Equal(s.T(), defaultBumpRules, flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_RULES", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagFrom() {
+	flag := cli.StringFlag(flagFrom().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "from", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_CHANGELOG_FROM", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagTo() {
+	flag := cli.StringFlag(flagTo().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "to", flag.Name)
+	assert.Equal(s.T(), "HEAD", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_CHANGELOG_TO", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagChangelog() {
+	flag := cli.StringFlag(flagChangelog().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "changelog, c", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_CHANGELOG", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagFile() {
+	flag := cli.StringSliceFlag(flagFile().(cli.StringSliceFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "file, F", flag.Name)
+	assert.Nil(s.T(), flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_FILE", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagDryRun() {
+	flag := cli.BoolFlag(flagDryRun().(cli.BoolFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "dry-run, n", flag.Name)
+	assert.Equal(s.T(), "GOSEMVER_DRY_RUN", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagConfig() {
+	flag := cli.StringFlag(flagConfig().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "config, C", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_CONFIG", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagBranch() {
+	flag := cli.StringFlag(flagBranch().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "branch", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_BRANCH", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagOutput() {
+	flag := cli.StringFlag(flagOutput().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "output, o", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_OUTPUT", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagFormat() {
+	flag := cli.StringFlag(flagFormat().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "format", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_FORMAT", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagChannels() {
+	flag := cli.StringFlag(flagChannels().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "channels", flag.Name)
+	assert.Equal(s.T(), defaultChannels, flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_CHANNELS", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagBuild() {
+	flag := cli.StringFlag(flagBuild().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "build", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_BUILD", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagDev() {
+	flag := cli.BoolFlag(flagDev().(cli.BoolFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "dev", flag.Name)
+	assert.Equal(s.T(), "GOSEMVER_DEV", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagComponent() {
+	flag := cli.StringFlag(flagComponent().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "component", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_COMPONENT", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagTagPattern() {
+	flag := cli.StringFlag(flagTagPattern().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "tag-pattern", flag.Name)
+	assert.Equal(s.T(), defaultTagPattern, flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_TAG_PATTERN", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagSince() {
+	flag := cli.StringFlag(flagSince().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "since", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_SINCE", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagStable() {
+	flag := cli.BoolFlag(flagStable().(cli.BoolFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "stable", flag.Name)
+	assert.Equal(s.T(), "GOSEMVER_STABLE", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagPrereleases() {
+	flag := cli.BoolFlag(flagPrereleases().(cli.BoolFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "prereleases", flag.Name)
+	assert.Equal(s.T(), "GOSEMVER_PRERELEASES", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagLimit() {
+	flag := cli.IntFlag(flagLimit().(cli.IntFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "limit", flag.Name)
+	assert.Equal(s.T(), 0, flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_LIMIT", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagDetails() {
+	flag := cli.BoolFlag(flagDetails().(cli.BoolFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "details", flag.Name)
+	assert.Equal(s.T(), "GOSEMVER_DETAILS", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagListOutput() {
+	flag := cli.StringFlag(flagListOutput().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "output, o", flag.Name)
+	assert.Equal(s.T(), "table", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_LIST_OUTPUT", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagMaxSatisfying() {
+	flag := cli.StringFlag(flagMaxSatisfying().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "max-satisfying", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_MAX_SATISFYING", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagExitCode() {
+	flag := cli.BoolFlag(flagExitCode().(cli.BoolFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "exit-code", flag.Name)
+	assert.Equal(s.T(), "GOSEMVER_EXIT_CODE", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagDiffOutput() {
+	flag := cli.StringFlag(flagDiffOutput().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "output, o", flag.Name)
+	assert.Equal(s.T(), "text", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_DIFF_OUTPUT", flag.EnvVar)
+}
+
+func (s *CLIFlagsTestSuite) Test_flagMainBranch() {
+	flag := cli.StringFlag(flagMainBranch().(cli.StringFlag))
+	assert.NotNil(s.T(), flag.Usage)
+	assert.Equal(s.T(), "main-branch", flag.Name)
+	assert.Equal(s.T(), "", flag.Value)
+	assert.Equal(s.T(), "GOSEMVER_MAIN_BRANCH", flag.EnvVar)
 }
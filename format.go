@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/zephinzer/semver/semver"
+)
+
+// formatPresets are the named templates accepted by --format
+var formatPresets = map[string]string{
+	// docker-tags prints the tags a stable release is pushed to an image
+	// repository under (1.2.3, 1.2 and 1), or only the full version for
+	// prereleases. build metadata is left out as '+' is not allowed in tags
+	"docker-tags": "{{.Major}}.{{.Minor}}.{{.Patch}}{{if .Prerelease}}-{{join .Prerelease \".\"}}{{else}}\n{{.Major}}.{{.Minor}}\n{{.Major}}{{end}}",
+	// msi prints a Windows file version (1.2.3.0)
+	"msi": "{{.Major}}.{{.Minor}}.{{.Patch}}.0",
+	// pep440 prints a Python package version (1.2.3rc1)
+	"pep440": "{{pep440 .}}",
+	// maven prints a Maven version, where prereleases are snapshots of the
+	// upcoming release (1.2.3-SNAPSHOT)
+	"maven": "{{maven .}}",
+}
+
+// formatFunctions are the functions available to --format templates
+var formatFunctions = template.FuncMap{
+	"join":   strings.Join,
+	"pep440": formatPEP440,
+	"maven":  formatMaven,
+}
+
+// renderFormat renders the :info using the :format, which is either the
+// name of one of the formatPresets or a text/template
+func renderFormat(info versionInfo, format string) (string, error) {
+	if preset, ok := formatPresets[format]; ok {
+		format = preset
+	}
+	parsedTemplate, err := template.New("format").Funcs(formatFunctions).Parse(format)
+	if err != nil {
+		return "", fmt.Errorf("invalid format '%s' specified: %s", format, err)
+	}
+	var output bytes.Buffer
+	if err := parsedTemplate.Execute(&output, info); err != nil {
+		return "", fmt.Errorf("invalid format '%s' specified: %s", format, err)
+	}
+	return output.String(), nil
+}
+
+// pep440Phases maps prerelease identifiers to PEP 440 prerelease phases
+var pep440Phases = map[string]string{
+	"a":       "a",
+	"alpha":   "a",
+	"b":       "b",
+	"beta":    "b",
+	"c":       "rc",
+	"rc":      "rc",
+	"pre":     "rc",
+	"preview": "rc",
+	"dev":     ".dev",
+	"post":    ".post",
+}
+
+// pep440LocalSeparators matches characters not allowed in the local
+// version label of a PEP 440 version
+var pep440LocalSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// formatPEP440 formats the :info as a PEP 440 version. the prerelease is
+// converted to a phase and number (eg. 'beta.2' to 'b2'), with unknown
+// phases treated as development releases, and the build metadata is kept
+// as the local version label
+func formatPEP440(info versionInfo) string {
+	version := fmt.Sprintf("%d.%d.%d", info.Major, info.Minor, info.Patch)
+	if len(info.Prerelease) > 0 {
+		phase, ok := pep440Phases[strings.ToLower(info.Prerelease[0])]
+		if !ok {
+			phase = ".dev"
+		}
+		number := "0"
+		for _, identifier := range info.Prerelease {
+			if semver.IsNumericIdentifier(identifier) {
+				number = strings.TrimLeft(identifier, "0")
+				if len(number) == 0 {
+					number = "0"
+				}
+				break
+			}
+		}
+		version += phase + number
+	}
+	if len(info.Build) > 0 {
+		version += "+" + strings.Trim(pep440LocalSeparators.ReplaceAllString(strings.ToLower(info.Build), "."), ".")
+	}
+	return version
+}
+
+// formatMaven formats the :info as a Maven version, with prereleases
+// formatted as a snapshot of the release
+func formatMaven(info versionInfo) string {
+	version := fmt.Sprintf("%d.%d.%d", info.Major, info.Minor, info.Patch)
+	if len(info.Prerelease) > 0 {
+		version += "-SNAPSHOT"
+	}
+	return version
+}
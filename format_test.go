@@ -0,0 +1,64 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type FormatTestSuite struct {
+	suite.Suite
+}
+
+func TestFormat(t *testing.T) {
+	suite.Run(t, new(FormatTestSuite))
+}
+
+// info returns the version info for a version with the :prerelease
+// identifiers and :build metadata
+func (s *FormatTestSuite) info(build string, prerelease ...string) versionInfo {
+	if prerelease == nil {
+		prerelease = []string{}
+	}
+	return versionInfo{Prefix: "v", Major: 1, Minor: 2, Patch: 3, Prerelease: prerelease, Build: build}
+}
+
+func (s *FormatTestSuite) Test_renderFormat_template() {
+	output, err := renderFormat(s.info("", "rc", "1"), "{{.Prefix}}{{.Major}}.{{.Minor}}{{with .Prerelease}} ({{join . \"-\"}}){{end}}")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "v1.2 (rc-1)", output)
+}
+
+func (s *FormatTestSuite) Test_renderFormat_invalid() {
+	_, err := renderFormat(s.info(""), "{{.Major")
+	assert.Contains(s.T(), err.Error(), "invalid format '{{.Major' specified")
+	_, err = renderFormat(s.info(""), "{{.Unknown}}")
+	assert.Contains(s.T(), err.Error(), "invalid format '{{.Unknown}}' specified")
+}
+
+func (s *FormatTestSuite) Test_renderFormat_presets() {
+	expectations := []struct {
+		preset   string
+		info     versionInfo
+		expected string
+	}{
+		{"docker-tags", s.info("build.5"), "1.2.3\n1.2\n1"},
+		{"docker-tags", s.info("build.5", "rc", "1"), "1.2.3-rc.1"},
+		{"msi", s.info("", "rc", "1"), "1.2.3.0"},
+		{"pep440", s.info(""), "1.2.3"},
+		{"pep440", s.info("", "alpha"), "1.2.3a0"},
+		{"pep440", s.info("", "beta", "02"), "1.2.3b2"},
+		{"pep440", s.info("", "rc", "1"), "1.2.3rc1"},
+		{"pep440", s.info("", "dev", "7"), "1.2.3.dev7"},
+		{"pep440", s.info("", "snapshot"), "1.2.3.dev0"},
+		{"pep440", s.info("Build-5.Linux"), "1.2.3+build.5.linux"},
+		{"maven", s.info(""), "1.2.3"},
+		{"maven", s.info("build.5", "rc", "1"), "1.2.3-SNAPSHOT"},
+	}
+	for _, expectation := range expectations {
+		output, err := renderFormat(expectation.info, expectation.preset)
+		assert.Nil(s.T(), err, expectation.expected)
+		assert.Equal(s.T(), expectation.expected, output)
+	}
+}
@@ -2,83 +2,297 @@ package main
 
 import (
 	"bytes"
-	"errors"
+	"fmt"
 	"os/exec"
+	"strconv"
 	"strings"
+
+	"github.com/zephinzer/semver/semver"
 )
 
-type GitLoader struct{}
+func init() {
+	registerVersionSource("git", func(options sourceOptions) VersionSource {
+		return &GitLoader{tagging: options.tagging}
+	})
+}
+
+// GitLoader loads versions from the tags of the git repository in the
+// current working directory
+type GitLoader struct {
+	tagging tagOptions
+}
+
+// Load returns a SemverLoader for the version selected by :mode, which is
+// one of:
+//   - "latest": the highest semver tag across all refs
+//   - "current": the most recent semver tag as described by `git describe`
+//   - "head": the highest semver tag pointing exactly at HEAD
+//   - "reachable": the highest semver tag reachable from HEAD
+func (gitLoader *GitLoader) Load(mode string, prefix ...string) semver.SemverLoader {
+	verifyGitExists()
+	return func() (int, int, int, string, string, string, error) {
+		var latest semver.ISemver
+		var err error
+		switch mode {
+		case "latest":
+			latest, err = gitLoader.getLatest(prefix...)
+		case "current":
+			latest, err = gitLoader.getCurrent(prefix...)
+		case "head":
+			latest, err = gitLoader.getHead(prefix...)
+			if err == nil && latest == nil {
+				err = &causedError{ErrNoTags, "no semver tags point at HEAD"}
+			}
+		case "reachable":
+			latest, err = gitLoader.getReachable(prefix...)
+		default:
+			err = fmt.Errorf("invalid mode '%s' specified", mode)
+		}
+		if err == nil && latest == nil {
+			err = ErrNoTags
+		}
+		if err != nil {
+			return 0, 0, 0, "", "", "", err
+		}
+		return toSemverLoader(latest)()
+	}
+}
+
+// List retrieves all semver tags in ascending order of precedence
+func (gitLoader *GitLoader) List(prefix ...string) ([]semver.ISemver, error) {
+	verifyGitExists()
+	tags, err := gitLoader.getAllTags(prefix...)
+	if err != nil {
+		return nil, err
+	}
+	semvers := make([]semver.ISemver, 0)
+	for _, semverTag := range filterSemverLike(tags, prefix...) {
+		semvers = append(semvers, semver.MustParse(semverTag, prefix...))
+	}
+	return semver.Sort(semvers), nil
+}
 
-func (gitLoader *GitLoader) Load(mode string, prefix ...string) SemverLoader {
+// Write tags the current commit with the :version
+func (gitLoader *GitLoader) Write(version semver.ISemver, change versionChange) error {
 	verifyGitExists()
-	return func() (int, int, int, string, string, error) {
-		var latest ISemver
-		if mode == "latest" {
-			latest = gitLoader.getLatest(prefix...)
-		} else if mode == "current" {
-			latest = gitLoader.getCurrent(prefix...)
+	return createTag(version.String(), gitLoader.tagging, change)
+}
+
+func (gitLoader *GitLoader) getLatest(prefix ...string) (semver.ISemver, error) {
+	tags, err := gitLoader.getAllTags(prefix...)
+	if err != nil {
+		return nil, err
+	}
+	return gitLoader.getHighest(tags, prefix...), nil
+}
+
+func (gitLoader *GitLoader) getCurrent(prefix ...string) (semver.ISemver, error) {
+	var patterns []string
+	if len(prefix) > 0 && len(prefix[0]) > 0 {
+		patterns = append(patterns, prefix[0]+"*")
+	}
+	var excludes []string
+	for {
+		tag, err := gitDescribeTag(patterns, excludes)
+		if err != nil {
+			return nil, err
 		}
-		if latest == nil {
-			return 0, 0, 0, "", "", errors.New("no tags found")
+		if current, err := semver.Parse(tag, prefix...); err == nil {
+			return current, nil
 		}
-		return latest.GetMajorInt(),
-			latest.GetMinorInt(),
-			latest.GetPatchInt(),
-			latest.GetLabel(),
-			latest.GetPrefix(),
-			nil
+		excludes = append(excludes, tag)
+	}
+}
+
+func (gitLoader *GitLoader) getHead(prefix ...string) (semver.ISemver, error) {
+	tags, err := gitTagList("--points-at", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	return gitLoader.getHighest(splitLines(tags), prefix...), nil
+}
+
+func (gitLoader *GitLoader) getReachable(prefix ...string) (semver.ISemver, error) {
+	tags, err := gitTagList("--merged", "HEAD")
+	if err != nil {
+		return nil, err
 	}
+	return gitLoader.getHighest(splitLines(tags), prefix...), nil
 }
 
-func (gitLoader *GitLoader) getLatest(prefix ...string) ISemver {
-	semvers := make([]ISemver, 0)
-	semverStrings := filterSemverLike(
-		gitLoader.getAllTags(prefix...),
-		prefix...,
-	)
+// getHighest returns the semver tag from :tags with the highest precedence,
+// or nil if none of the :tags are semver versions
+func (gitLoader *GitLoader) getHighest(tags []string, prefix ...string) semver.ISemver {
+	semvers := make([]semver.ISemver, 0)
+	semverStrings := filterSemverLike(tags, prefix...)
 	for _, semverTag := range semverStrings {
-		semvers = append(semvers, toSemver(semverTag, prefix...))
+		semvers = append(semvers, semver.MustParse(semverTag, prefix...))
+	}
+	if len(semvers) == 0 {
+		return nil
 	}
-	semvers = Sort(semvers)
+	semvers = semver.Sort(semvers)
 	return semvers[len(semvers)-1]
 }
 
-func (gitLoader *GitLoader) getCurrent(prefix ...string) ISemver {
-	return toSemver(gitDescribeTag(), prefix...)
+func (gitLoader *GitLoader) getAllTags(prefix ...string) ([]string, error) {
+	tags, err := gitTagList()
+	if err != nil {
+		return nil, err
+	}
+	return splitLines(tags), nil
+}
+
+// git runs git with the provided :args, returning its standard output. the
+// standard error output is used to describe the error if the command fails
+func git(args ...string) (string, error) {
+	var output bytes.Buffer
+	var errorOutput bytes.Buffer
+	command := exec.Command("git", args...)
+	command.Stdout = &output
+	command.Stderr = &errorOutput
+	if err := command.Run(); err != nil {
+		return trimAndNormalise(output.String()), toGitError(trimAndNormalise(errorOutput.String()), err)
+	}
+	return trimAndNormalise(output.String()), nil
+}
+
+// gitTagList retrieves all git tags, filtered by any of the :filters
+// accepted by `git tag --list`
+func gitTagList(filters ...string) (string, error) {
+	return git(append([]string{"tag", "--list"}, filters...)...)
+}
+
+// gitDescribeTag retrieves the most recent tag, limited to those matching
+// any of the glob :patterns and none of the :excludes if specified
+func gitDescribeTag(patterns []string, excludes []string) (string, error) {
+	args := []string{"describe", "--tags", "--abbrev=0"}
+	for _, pattern := range patterns {
+		args = append(args, "--match", pattern)
+	}
+	for _, exclude := range excludes {
+		args = append(args, "--exclude", exclude)
+	}
+	return git(args...)
+}
+
+// gitTag tags a commit with the given tag, passing any :flags to `git tag`
+func gitTag(tag string, flags ...string) (string, error) {
+	return git(append(append([]string{"tag"}, flags...), tag)...)
+}
+
+// gitLog retrieves the full messages of the commits in the :revisionRange,
+// limited to those changing any of the :paths if specified
+func gitLog(revisionRange string, paths ...string) ([]string, error) {
+	args := []string{"log", "--format=%B%x00", revisionRange}
+	if len(paths) > 0 {
+		args = append(append(args, "--"), paths...)
+	}
+	output, err := git(args...)
+	if err != nil {
+		return nil, err
+	}
+	var messages []string
+	for _, message := range strings.Split(output, "\x00") {
+		if message = trimAndNormalise(message); len(message) > 0 {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+// gitCommitDate retrieves the date of the commit :ref points to in the
+// YYYY-MM-DD format
+func gitCommitDate(ref string) (string, error) {
+	return git("log", "-1", "--format=%cd", "--date=short", ref)
+}
+
+// gitRevParse returns the SHA of the commit :ref points to
+func gitRevParse(ref string) (string, error) {
+	return git("rev-parse", "--verify", "--quiet", ref+"^{commit}")
+}
+
+// gitShortSHA returns the abbreviated SHA of the commit :ref points to
+func gitShortSHA(ref string) (string, error) {
+	return git("rev-parse", "--short", ref+"^{commit}")
+}
+
+// gitRevListCount returns the number of commits in the :revisionRange
+func gitRevListCount(revisionRange string) (int, error) {
+	output, err := git("rev-list", "--count", revisionRange)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(output)
+}
+
+// gitIsDirty returns true if the worktree or index have changes to tracked
+// files
+func gitIsDirty() (bool, error) {
+	output, err := git("status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return false, err
+	}
+	return len(output) > 0, nil
+}
+
+// gitMergeBase returns the SHA of the best common ancestor of the commits
+// :a and :b point to
+func gitMergeBase(a string, b string) (string, error) {
+	return git("merge-base", a, b)
+}
+
+// gitDefaultBranch returns the branch the remote 'origin' considers its
+// default, or the first of 'main' and 'master' which exists locally
+func gitDefaultBranch() (string, error) {
+	if branch, err := git("symbolic-ref", "--quiet", "--short", "refs/remotes/origin/HEAD"); err == nil && len(branch) > 0 {
+		return branch, nil
+	}
+	for _, branch := range []string{"main", "master"} {
+		if _, err := gitRevParse("refs/heads/" + branch); err == nil {
+			return branch, nil
+		}
+	}
+	return "", fmt.Errorf("the main branch could not be determined, specify it using --main-branch")
+}
+
+// gitAdd stages the files at the :paths
+func gitAdd(paths ...string) (string, error) {
+	return git(append([]string{"add", "--"}, paths...)...)
 }
 
-func (gitLoader *GitLoader) getAllTags(prefix ...string) []string {
-	return removeEmptyStringsFromStringSlice(strings.Split(gitTagList(), "\n"))
+// gitCommit commits the staged changes with the :message
+func gitCommit(message string) (string, error) {
+	return git("commit", "--message", message)
 }
 
-// gitTagList retrieves all git tags
-func gitTagList() string {
-	var allTagsRawOutput bytes.Buffer
-	getVersion := exec.Command("git", "tag", "--list")
-	getVersion.Stdout = &allTagsRawOutput
-	getVersion.Stderr = &allTagsRawOutput
-	getVersion.Run()
-	return trimAndNormalise(allTagsRawOutput.String())
+// gitTopLevel returns the path to the root of the repository
+func gitTopLevel() (string, error) {
+	return git("rev-parse", "--show-toplevel")
 }
 
-// gitDescribeTag retrieves the most recent tag
-func gitDescribeTag() string {
-	var versionOutput bytes.Buffer
-	getVersion := exec.Command("git", "describe", "--tags", "--abbrev=0")
-	getVersion.Stdout = &versionOutput
-	getVersion.Stderr = &versionOutput
-	getVersion.Run()
-	return trimAndNormalise(versionOutput.String())
+// gitCurrentBranch returns the name of the checked out branch, or "HEAD"
+// if HEAD is detached
+func gitCurrentBranch() (string, error) {
+	return git("rev-parse", "--abbrev-ref", "HEAD")
 }
 
-// gitTag tags a commit with the given tag
-func gitTag(tag string) string {
-	var anyOutput bytes.Buffer
-	getVersion := exec.Command("git", "tag", tag)
-	getVersion.Stdout = &anyOutput
-	getVersion.Stderr = &anyOutput
-	getVersion.Run()
-	return trimAndNormalise(anyOutput.String())
+// gitPushTag pushes only the given tag to the :remote
+func gitPushTag(remote string, tag string) (string, error) {
+	output, err := git("push", remote, "refs/tags/"+tag)
+	if err != nil {
+		return output, fmt.Errorf("failed to push tag '%s' to '%s': %s", tag, remote, err)
+	}
+	return output, nil
+}
+
+// gitTagDelete deletes the given tag from the local repository
+func gitTagDelete(tag string) (string, error) {
+	output, err := git("tag", "--delete", tag)
+	if err != nil {
+		return output, fmt.Errorf("failed to delete tag '%s': %s", tag, err)
+	}
+	return output, nil
 }
 
 // verifyGitExists panics if Git is not found
@@ -1,15 +1,209 @@
 package main
 
 import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"github.com/zephinzer/semver/semver"
 )
 
-type GitLoaderTestSuite struct {
+// GitRepositoryTestSuite runs each test within a freshly initialised git
+// repository and can be embedded by suites which need one
+type GitRepositoryTestSuite struct {
 	suite.Suite
+	originalDirectory   string
+	repositoryDirectory string
+	remoteDirectories   []string
+}
+
+func (s *GitRepositoryTestSuite) SetupTest() {
+	var err error
+	if s.originalDirectory, err = os.Getwd(); err != nil {
+		panic(err)
+	}
+	if s.repositoryDirectory, err = ioutil.TempDir("", "gosemver-test-"); err != nil {
+		panic(err)
+	}
+	if err = os.Chdir(s.repositoryDirectory); err != nil {
+		panic(err)
+	}
+	s.git("init")
+	s.git("config", "user.name", "gosemver")
+	s.git("config", "user.email", "gosemver@example.com")
+	s.git("config", "commit.gpgsign", "false")
+	s.git("config", "tag.gpgsign", "false")
+}
+
+func (s *GitRepositoryTestSuite) TearDownTest() {
+	os.Chdir(s.originalDirectory)
+	os.RemoveAll(s.repositoryDirectory)
+	for _, remoteDirectory := range s.remoteDirectories {
+		os.RemoveAll(remoteDirectory)
+	}
+	s.remoteDirectories = nil
+}
+
+// git runs git with the provided :args in the test repository and fails the
+// test if the command does not succeed
+func (s *GitRepositoryTestSuite) git(args ...string) string {
+	output, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		s.T().Fatalf("git %s: %s: %s", strings.Join(args, " "), err, output)
+	}
+	return trimAndNormalise(string(output))
+}
+
+// commit creates an empty commit with the provided :message
+func (s *GitRepositoryTestSuite) commit(message string) {
+	s.git("commit", "--allow-empty", "--message", message)
+}
+
+// addRemote creates a bare repository and adds it as a remote named :name
+// of the test repository, returning the path to the bare repository
+func (s *GitRepositoryTestSuite) addRemote(name string) string {
+	remoteDirectory, err := ioutil.TempDir("", "gosemver-remote-")
+	if err != nil {
+		panic(err)
+	}
+	s.remoteDirectories = append(s.remoteDirectories, remoteDirectory)
+	s.git("init", "--bare", remoteDirectory)
+	s.git("remote", "add", name, remoteDirectory)
+	return remoteDirectory
+}
+
+type GitLoaderTestSuite struct {
+	GitRepositoryTestSuite
 }
 
 func TestGitLoader(t *testing.T) {
 	suite.Run(t, new(GitLoaderTestSuite))
 }
+
+// load is a convenience method for loading a version using :mode
+func (s *GitLoaderTestSuite) load(mode string, prefix ...string) (*semver.Semver, error) {
+	loader := GitLoader{}
+	return semver.NewFrom(loader.Load(mode, prefix...))
+}
+
+// setupDivergedTags creates 1.0.0 on the main line, 2.0.0 on an unmerged
+// branch, and returns to the main line
+func (s *GitLoaderTestSuite) setupDivergedTags() {
+	s.commit("initial commit")
+	s.git("tag", "1.0.0")
+	mainline := s.git("rev-parse", "--abbrev-ref", "HEAD")
+	s.git("checkout", "-b", "feature")
+	s.commit("feature commit")
+	s.git("tag", "2.0.0")
+	s.git("checkout", mainline)
+}
+
+func (s *GitLoaderTestSuite) TestLoad_latest() {
+	s.setupDivergedTags()
+	version, err := s.load("latest")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "2.0.0", version.String())
+}
+
+func (s *GitLoaderTestSuite) TestLoad_latestWithPrefix() {
+	s.commit("initial commit")
+	s.git("tag", "v1.0.0")
+	s.git("tag", "v1.1.0-rc.1")
+	s.git("tag", "1.5.0")
+	version, err := s.load("latest", "v")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "v1.1.0-rc.1", version.String())
+}
+
+func (s *GitLoaderTestSuite) TestLoad_current() {
+	s.setupDivergedTags()
+	s.commit("another commit")
+	version, err := s.load("current")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "1.0.0", version.String())
+}
+
+func (s *GitLoaderTestSuite) TestLoad_currentSkipsNonSemverTags() {
+	s.commit("initial commit")
+	s.git("tag", "1.0.0")
+	s.commit("another commit")
+	s.git("tag", "deploy-prod")
+	s.commit("yet another commit")
+	s.git("tag", "nightly")
+	version, err := s.load("current")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "1.0.0", version.String())
+}
+
+func (s *GitLoaderTestSuite) TestLoad_head() {
+	s.setupDivergedTags()
+	version, err := s.load("head")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "1.0.0", version.String())
+	s.commit("untagged commit")
+	_, err = s.load("head")
+	assert.EqualError(s.T(), err, "no semver tags point at HEAD")
+}
+
+func (s *GitLoaderTestSuite) TestLoad_reachable() {
+	s.setupDivergedTags()
+	s.commit("untagged commit")
+	version, err := s.load("reachable")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "1.0.0", version.String())
+}
+
+func (s *GitLoaderTestSuite) TestLoad_invalidMode() {
+	_, err := s.load("unknown")
+	assert.EqualError(s.T(), err, "invalid mode 'unknown' specified")
+}
+
+func (s *GitLoaderTestSuite) TestLoad_noTags() {
+	s.commit("initial commit")
+	for _, mode := range []string{"latest", "current", "head", "reachable"} {
+		_, err := s.load(mode)
+		assert.Equal(s.T(), ErrNoTags, errorCause(err), mode)
+	}
+}
+
+func (s *GitLoaderTestSuite) TestLoad_notARepository() {
+	notARepository, err := ioutil.TempDir("", "gosemver-not-a-repository-")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(notARepository)
+	os.Chdir(notARepository)
+	_, err = s.load("latest")
+	assert.Equal(s.T(), ErrNotARepository, errorCause(err))
+}
+
+func (s *GitLoaderTestSuite) Test_gitTag_exists() {
+	s.commit("initial commit")
+	_, err := gitTag("1.0.0")
+	assert.Nil(s.T(), err)
+	_, err = gitTag("1.0.0")
+	assert.Equal(s.T(), ErrTagExists, errorCause(err))
+}
+
+func (s *GitLoaderTestSuite) Test_gitDescribeTag() {
+	s.commit("initial commit")
+	_, err := gitDescribeTag(nil, nil)
+	assert.Equal(s.T(), ErrNoTags, errorCause(err))
+	s.git("tag", "1.0.0")
+	s.commit("another commit")
+	tag, err := gitDescribeTag(nil, nil)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "1.0.0", tag)
+	s.git("tag", "api/v0.1.0")
+	s.commit("yet another commit")
+	tag, err = gitDescribeTag([]string{"1.*"}, nil)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "1.0.0", tag)
+	tag, err = gitDescribeTag(nil, []string{"api/v0.1.0"})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "1.0.0", tag)
+}
@@ -3,6 +3,8 @@ module github.com/zephinzer/semver
 go 1.11
 
 require (
+	github.com/BurntSushi/toml v0.3.0
 	github.com/stretchr/testify v1.3.0
 	github.com/urfave/cli v1.20.0
+	gopkg.in/yaml.v2 v2.4.0
 )
@@ -0,0 +1,318 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/zephinzer/semver/semver"
+)
+
+// lintRules lists the rules tags are audited against by `lint`, in the
+// order their issues are reported
+var lintRules = []string{"mixed-prefix", "not-semver", "skipped-version", "duplicate-version", "late-prerelease", "unreachable"}
+
+// versionLike matches tags which look like they are meant to be versions
+var versionLike = regexp.MustCompile(`(^|[^0-9A-Za-z])[vV]?\d+\.\d+`)
+
+// lintOptions defines how the tags are audited
+type lintOptions struct {
+	// mainBranch is the branch all versions should be reachable from,
+	// defaults to the default branch of the repository
+	mainBranch string
+}
+
+// lintIssue is a problem found in the tags of the repository
+type lintIssue struct {
+	rule    string
+	message string
+}
+
+// String formats the issue as '<rule>: <message>'
+func (issue lintIssue) String() string {
+	return issue.rule + ": " + issue.message
+}
+
+// taggedVersion is a tag holding a semver version
+type taggedVersion struct {
+	tag     string
+	prefix  string
+	version semver.ISemver
+	commit  string
+}
+
+// namespace returns the prefix of the tag without a trailing 'v', which
+// tags of the same versions share whether or not they use a 'v'
+func (tagged taggedVersion) namespace() string {
+	return strings.TrimSuffix(strings.TrimSuffix(tagged.prefix, "v"), "V")
+}
+
+// splitTag splits the :tag into its prefix and the semver version it ends
+// with, returning false if it does not end with one
+func splitTag(tag string) (string, semver.ISemver, bool) {
+	for index := 0; index < len(tag); index++ {
+		if !isDigit(tag[index]) || (index > 0 && isDigit(tag[index-1])) {
+			continue
+		}
+		if version, err := semver.Parse(tag, tag[:index]); err == nil {
+			return tag[:index], version, true
+		}
+	}
+	return "", nil, false
+}
+
+// isDigit returns true if the :character is a digit
+func isDigit(character byte) bool {
+	return character >= '0' && character <= '9'
+}
+
+// lintTags audits all tags of the repository, returning the issues found
+// in the order of lintRules
+func lintTags(options lintOptions) ([]lintIssue, error) {
+	tags, err := gitTagList()
+	if err != nil {
+		return nil, err
+	}
+	var issues []lintIssue
+	var versions []taggedVersion
+	for _, tag := range splitLines(tags) {
+		prefix, version, ok := splitTag(tag)
+		if !ok {
+			if location := versionLike.FindStringIndex(tag); location != nil {
+				issues = append(issues, lintNotSemver(tag, tag[location[0]:]))
+			}
+			continue
+		}
+		commit, err := gitRevParse("refs/tags/" + tag)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, taggedVersion{tag, prefix, version, commit})
+	}
+	issues = append(issues, lintMixedPrefixes(versions)...)
+	issues = append(issues, lintSkippedVersions(versions)...)
+	issues = append(issues, lintDuplicateVersions(versions)...)
+	latePrereleases, err := lintLatePrereleases(versions)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, latePrereleases...)
+	unreachable, err := lintUnreachable(versions, options.mainBranch)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, unreachable...)
+	sort.SliceStable(issues, func(i, j int) bool {
+		return lintRuleRank(issues[i].rule) < lintRuleRank(issues[j].rule)
+	})
+	return issues, nil
+}
+
+// lintRuleRank returns the position of the :rule in lintRules
+func lintRuleRank(rule string) int {
+	for rank, lintRule := range lintRules {
+		if lintRule == rule {
+			return rank
+		}
+	}
+	return len(lintRules)
+}
+
+// lintNotSemver describes why the :tag, which looks like a version from the
+// :versionLike part on, is not a semver version
+func lintNotSemver(tag string, versionLike string) lintIssue {
+	candidate := strings.TrimLeftFunc(versionLike, func(character rune) bool { return !unicode.IsDigit(character) })
+	reason := "it is not a valid semver version"
+	if _, err := semver.Parse(candidate); err != nil {
+		if parseError, ok := err.(*semver.ParseError); ok {
+			reason = parseError.Reason
+		}
+	}
+	return lintIssue{"not-semver", fmt.Sprintf("%s looks like a version but is not valid semver: %s", tag, reason)}
+}
+
+// lintMixedPrefixes reports tags of the same namespace which are prefixed
+// inconsistently, such as 'v1.2.3' and '1.2.4'
+func lintMixedPrefixes(versions []taggedVersion) []lintIssue {
+	namespaces := map[string]map[string][]string{}
+	var names []string
+	for _, tagged := range versions {
+		if _, ok := namespaces[tagged.namespace()]; !ok {
+			namespaces[tagged.namespace()] = map[string][]string{}
+			names = append(names, tagged.namespace())
+		}
+		namespaces[tagged.namespace()][tagged.prefix] = append(namespaces[tagged.namespace()][tagged.prefix], tagged.tag)
+	}
+	sort.Strings(names)
+	var issues []lintIssue
+	for _, namespace := range names {
+		prefixes := namespaces[namespace]
+		if len(prefixes) < 2 {
+			continue
+		}
+		var prefixNames []string
+		for prefix := range prefixes {
+			prefixNames = append(prefixNames, prefix)
+		}
+		sort.Strings(prefixNames)
+		var usages []string
+		for _, prefix := range prefixNames {
+			description := fmt.Sprintf("prefix '%s'", prefix)
+			if len(prefix) == 0 {
+				description = "no prefix"
+			}
+			usages = append(usages, fmt.Sprintf("%d with %s (eg. %s)", len(prefixes[prefix]), description, prefixes[prefix][0]))
+		}
+		scope := "tags"
+		if len(namespace) > 0 {
+			scope = fmt.Sprintf("tags under '%s'", namespace)
+		}
+		issues = append(issues, lintIssue{"mixed-prefix", fmt.Sprintf("%s use different prefixes: %s", scope, strings.Join(usages, ", "))})
+	}
+	return issues
+}
+
+// lintSkippedVersions reports stable versions which do not follow the
+// previous stable version with the same prefix, such as 1.5.0 following
+// 1.3.0
+func lintSkippedVersions(versions []taggedVersion) []lintIssue {
+	byPrefix := map[string][]semver.ISemver{}
+	var prefixes []string
+	for _, tagged := range versions {
+		if len(tagged.version.GetLabel()) > 0 {
+			continue
+		}
+		if _, ok := byPrefix[tagged.prefix]; !ok {
+			prefixes = append(prefixes, tagged.prefix)
+		}
+		byPrefix[tagged.prefix] = append(byPrefix[tagged.prefix], tagged.version)
+	}
+	sort.Strings(prefixes)
+	var issues []lintIssue
+	for _, prefix := range prefixes {
+		stable := semver.Sort(byPrefix[prefix])
+		for index := 1; index < len(stable); index++ {
+			previous, next := stable[index-1], stable[index]
+			if skipped := skippedVersion(previous, next); skipped != nil {
+				issues = append(issues, lintIssue{"skipped-version", fmt.Sprintf("%s follows %s, skipping %s", next, previous, skipped)})
+			}
+		}
+	}
+	return issues
+}
+
+// skippedVersion returns the first version skipped between the consecutive
+// stable versions :previous and :next, or nil if none was skipped
+func skippedVersion(previous semver.ISemver, next semver.ISemver) semver.ISemver {
+	major, minor, patch := previous.GetMajorInt(), previous.GetMinorInt(), previous.GetPatchInt()
+	switch {
+	case next.GetMajorInt() == major && next.GetMinorInt() == minor:
+		if next.GetPatchInt() > patch+1 {
+			return semver.New(major, minor, patch+1, "", previous.GetPrefix())
+		}
+	case next.GetMajorInt() == major:
+		if next.GetMinorInt() > minor+1 || next.GetPatchInt() != 0 {
+			return semver.New(major, minor+1, 0, "", previous.GetPrefix())
+		}
+	default:
+		if next.GetMajorInt() > major+1 || next.GetMinorInt() != 0 || next.GetPatchInt() != 0 {
+			return semver.New(major+1, 0, 0, "", previous.GetPrefix())
+		}
+	}
+	return nil
+}
+
+// lintDuplicateVersions reports versions of the same namespace which are
+// tagged on different commits, such as 'v1.2.3' and '1.2.3' or '1.2.3' and
+// '1.2.3+build.1'
+func lintDuplicateVersions(versions []taggedVersion) []lintIssue {
+	duplicates := map[string][]taggedVersion{}
+	var keys []string
+	for _, tagged := range versions {
+		key := fmt.Sprintf("%s\x00%d.%d.%d-%s", tagged.namespace(), tagged.version.GetMajorInt(), tagged.version.GetMinorInt(), tagged.version.GetPatchInt(), tagged.version.GetLabel())
+		if _, ok := duplicates[key]; !ok {
+			keys = append(keys, key)
+		}
+		duplicates[key] = append(duplicates[key], tagged)
+	}
+	sort.Strings(keys)
+	var issues []lintIssue
+	for _, key := range keys {
+		var tags, commits []string
+		for _, tagged := range duplicates[key] {
+			tags = append(tags, tagged.tag)
+			if !sliceContainsString(commits, tagged.commit) {
+				commits = append(commits, tagged.commit)
+			}
+		}
+		if len(commits) < 2 {
+			continue
+		}
+		for index, commit := range commits {
+			if len(commit) > 7 {
+				commits[index] = commit[:7]
+			}
+		}
+		issues = append(issues, lintIssue{"duplicate-version", fmt.Sprintf("%s are the same version on different commits (%s)", strings.Join(tags, " and "), strings.Join(commits, ", "))})
+	}
+	return issues
+}
+
+// lintLatePrereleases reports prereleases tagged on commits made after the
+// commit of their release, such as 1.2.3-rc.1 after 1.2.3
+func lintLatePrereleases(versions []taggedVersion) ([]lintIssue, error) {
+	releases := map[string]taggedVersion{}
+	for _, tagged := range versions {
+		if len(tagged.version.GetLabel()) == 0 {
+			releases[tagged.prefix+releaseCore(tagged.version)] = tagged
+		}
+	}
+	var issues []lintIssue
+	for _, tagged := range versions {
+		release, ok := releases[tagged.prefix+releaseCore(tagged.version)]
+		if len(tagged.version.GetLabel()) == 0 || !ok || release.commit == tagged.commit {
+			continue
+		}
+		mergeBase, err := gitMergeBase(release.commit, tagged.commit)
+		if err != nil {
+			return nil, err
+		}
+		if mergeBase == release.commit {
+			issues = append(issues, lintIssue{"late-prerelease", fmt.Sprintf("%s was tagged on a commit after its release %s", tagged.tag, release.tag)})
+		}
+	}
+	return issues, nil
+}
+
+// releaseCore returns the major, minor and patch version of the :version
+func releaseCore(version semver.ISemver) string {
+	return fmt.Sprintf("%d.%d.%d", version.GetMajorInt(), version.GetMinorInt(), version.GetPatchInt())
+}
+
+// lintUnreachable reports versions whose tags are not reachable from the
+// :mainBranch, which defaults to the default branch of the repository
+func lintUnreachable(versions []taggedVersion, mainBranch string) ([]lintIssue, error) {
+	if len(versions) == 0 {
+		return nil, nil
+	}
+	if len(mainBranch) == 0 {
+		var err error
+		if mainBranch, err = gitDefaultBranch(); err != nil {
+			return nil, err
+		}
+	}
+	unmerged, err := gitTagList("--no-merged", mainBranch)
+	if err != nil {
+		return nil, err
+	}
+	unmergedTags := splitLines(unmerged)
+	var issues []lintIssue
+	for _, tagged := range versions {
+		if sliceContainsString(unmergedTags, tagged.tag) {
+			issues = append(issues, lintIssue{"unreachable", fmt.Sprintf("%s is not reachable from the main branch '%s'", tagged.tag, mainBranch)})
+		}
+	}
+	return issues, nil
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/zephinzer/semver/semver"
+)
+
+type LintTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestLint(t *testing.T) {
+	suite.Run(t, new(LintTestSuite))
+}
+
+// lint returns the issues found in the tags of the test repository as
+// strings
+func (s *LintTestSuite) lint() []string {
+	issues, err := lintTags(lintOptions{mainBranch: "main"})
+	assert.Nil(s.T(), err)
+	var lines []string
+	for _, issue := range issues {
+		lines = append(lines, issue.String())
+	}
+	return lines
+}
+
+func (s *LintTestSuite) Test_splitTag() {
+	prefix, version, ok := splitTag("services/api2/v1.2.3-rc.1")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "services/api2/v", prefix)
+	assert.Equal(s.T(), "services/api2/v1.2.3-rc.1", version.String())
+	prefix, _, ok = splitTag("10.2.3")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "", prefix)
+	_, _, ok = splitTag("v1.2")
+	assert.False(s.T(), ok)
+	_, _, ok = splitTag("v01.2.3")
+	assert.False(s.T(), ok)
+}
+
+func (s *LintTestSuite) Test_skippedVersion() {
+	assert.Nil(s.T(), skippedVersion(semver.MustParse("1.2.3"), semver.MustParse("1.2.4")))
+	assert.Nil(s.T(), skippedVersion(semver.MustParse("1.2.3"), semver.MustParse("1.3.0")))
+	assert.Nil(s.T(), skippedVersion(semver.MustParse("1.2.3"), semver.MustParse("2.0.0")))
+	assert.Equal(s.T(), "1.2.4", skippedVersion(semver.MustParse("1.2.3"), semver.MustParse("1.2.6")).String())
+	assert.Equal(s.T(), "1.3.0", skippedVersion(semver.MustParse("1.2.3"), semver.MustParse("1.3.1")).String())
+	assert.Equal(s.T(), "1.3.0", skippedVersion(semver.MustParse("1.2.3"), semver.MustParse("1.4.0")).String())
+	assert.Equal(s.T(), "v2.0.0", skippedVersion(semver.MustParse("v1.2.3", "v"), semver.MustParse("v3.0.0", "v")).String())
+}
+
+func (s *LintTestSuite) Test_lintTags_clean() {
+	s.git("symbolic-ref", "HEAD", "refs/heads/main")
+	s.commit("initial commit")
+	s.git("tag", "v1.0.0-rc.1")
+	s.commit("feat: second commit")
+	s.git("tag", "v1.0.0")
+	s.git("tag", "v1.1.0")
+	s.git("tag", "release-candidate")
+	assert.Empty(s.T(), s.lint())
+}
+
+func (s *LintTestSuite) Test_lintTags() {
+	s.git("symbolic-ref", "HEAD", "refs/heads/main")
+	s.commit("initial commit")
+	for _, tag := range []string{"v1.0.0", "v1.2", "services/api/v0.1.0"} {
+		s.git("tag", tag)
+	}
+	s.commit("fix: second commit")
+	for _, tag := range []string{"1.0.0", "v1.0.0-rc.1", "v1.2.0"} {
+		s.git("tag", tag)
+	}
+	s.git("checkout", "-q", "-b", "feature")
+	s.commit("feat: unmerged commit")
+	s.git("tag", "v1.3.0")
+	assert.Equal(s.T(), []string{
+		"mixed-prefix: tags use different prefixes: 1 with no prefix (eg. 1.0.0), 4 with prefix 'v' (eg. v1.0.0)",
+		"not-semver: v1.2 looks like a version but is not valid semver: expected a version core of the form MAJOR.MINOR.PATCH",
+		"skipped-version: v1.2.0 follows v1.0.0, skipping v1.1.0",
+		fmt.Sprintf("duplicate-version: 1.0.0 and v1.0.0 are the same version on different commits (%s, %s)", s.git("rev-parse", "--short=7", "1.0.0"), s.git("rev-parse", "--short=7", "v1.0.0")),
+		"late-prerelease: v1.0.0-rc.1 was tagged on a commit after its release v1.0.0",
+		"unreachable: v1.3.0 is not reachable from the main branch 'main'",
+	}, s.lint())
+}
+
+func (s *LintTestSuite) Test_lintTags_mainBranch() {
+	s.commit("initial commit")
+	s.git("tag", "v1.0.0")
+	s.git("branch", "-M", "trunk")
+	_, err := lintTags(lintOptions{})
+	assert.EqualError(s.T(), err, "the main branch could not be determined, specify it using --main-branch")
+	issues, err := lintTags(lintOptions{mainBranch: "trunk"})
+	assert.Nil(s.T(), err)
+	assert.Empty(s.T(), issues)
+}
+
+func (s *LintTestSuite) Test_cliLint() {
+	s.git("symbolic-ref", "HEAD", "refs/heads/main")
+	s.commit("initial commit")
+	s.git("tag", "v1.0.0")
+	assert.Nil(s.T(), cliLint("", lintOptions{}))
+	assert.EqualError(s.T(), cliLint("help", lintOptions{}), "help requested")
+	s.git("tag", "v1.0.2")
+	defer func() {
+		err := recover().(error)
+		assert.Equal(s.T(), ErrLintIssues, errorCause(err))
+		assert.EqualError(s.T(), err, "1 issues found")
+	}()
+	cliLint("", lintOptions{})
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
+)
+
+// listOutputs lists the formats supported by --output for `list`
+var listOutputs = []string{"table", "json"}
+
+// listOptions defines which versions are listed and how they are printed
+type listOptions struct {
+	// since excludes versions lower than it if specified
+	since string
+	// stable only lists versions without a prerelease
+	stable bool
+	// prereleases only lists versions with a prerelease
+	prereleases bool
+	// limit only lists the given number of highest versions if above 0
+	limit int
+	// details includes the commit and date of the tag of each version
+	details bool
+	// output is the format to print the versions in, one of listOutputs
+	output string
+}
+
+// getListOptions retrieves the list options from the flags of a command
+func getListOptions(c *cli.Context) listOptions {
+	return listOptions{
+		since:       c.String("since"),
+		stable:      c.Bool("stable"),
+		prereleases: c.Bool("prereleases"),
+		limit:       c.Int("limit"),
+		details:     c.Bool("details"),
+		output:      strings.ToLower(c.String("output")),
+	}
+}
+
+// filter returns the :versions selected by the options, keeping their
+// ascending order of precedence
+func (options listOptions) filter(versions []semver.ISemver, prefix string) ([]semver.ISemver, error) {
+	if options.stable && options.prereleases {
+		return nil, fmt.Errorf("only one of --stable or --prereleases can be specified")
+	}
+	if options.limit < 0 {
+		return nil, fmt.Errorf("invalid limit '%d' specified, expected a positive number", options.limit)
+	}
+	var since semver.ISemver
+	if len(options.since) > 0 {
+		parsedSince, err := parseVersionArgument(options.since, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version '%s' specified for --since: %s", options.since, err)
+		}
+		since = parsedSince
+	}
+	filtered := make([]semver.ISemver, 0)
+	for _, version := range versions {
+		isPrerelease := len(version.GetLabel()) > 0
+		if (options.stable && isPrerelease) || (options.prereleases && !isPrerelease) {
+			continue
+		}
+		if since != nil && semver.Compare(version, since) < 0 {
+			continue
+		}
+		filtered = append(filtered, version)
+	}
+	if options.limit > 0 && len(filtered) > options.limit {
+		filtered = filtered[len(filtered)-options.limit:]
+	}
+	return filtered, nil
+}
+
+// listedVersion holds the fields of a version printed by `list`
+type listedVersion struct {
+	Version    string `json:"version"`
+	Prerelease bool   `json:"prerelease"`
+	// Tag is the ref of the tag of the version, empty if it is not tagged
+	Tag string `json:"tag,omitempty"`
+	// Commit is the SHA of the tagged commit, only included with --details
+	Commit string `json:"commit,omitempty"`
+	// Date is the date of the tagged commit, only included with --details
+	Date string `json:"date,omitempty"`
+}
+
+// newListedVersions collects the fields of the :versions from the :source.
+// the tag fields are only filled in for git sources
+func newListedVersions(versions []semver.ISemver, source VersionSource, details bool) ([]listedVersion, error) {
+	_, isGit := source.(*GitLoader)
+	listed := make([]listedVersion, 0, len(versions))
+	for _, version := range versions {
+		entry := listedVersion{Version: version.String(), Prerelease: len(version.GetLabel()) > 0}
+		if isGit {
+			entry.Tag = "refs/tags/" + version.String()
+			if details {
+				var err error
+				if entry.Commit, err = gitRevParse(entry.Tag); err != nil {
+					return nil, err
+				}
+				if entry.Date, err = gitCommitDate(entry.Tag); err != nil {
+					return nil, err
+				}
+			}
+		}
+		listed = append(listed, entry)
+	}
+	return listed, nil
+}
+
+// renderList renders the :listed versions in the :output, one of
+// listOutputs. tables list one version per line, along with the commit and
+// date of its tag if :details are requested
+func renderList(listed []listedVersion, output string, details bool) (string, error) {
+	switch output {
+	case "", "table":
+		var rendered strings.Builder
+		if !details {
+			for _, entry := range listed {
+				rendered.WriteString(entry.Version + "\n")
+			}
+			return rendered.String(), nil
+		}
+		table := tabwriter.NewWriter(&rendered, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(table, "VERSION\tCOMMIT\tDATE")
+		for _, entry := range listed {
+			commit := entry.Commit
+			if len(commit) > 7 {
+				commit = commit[:7]
+			}
+			fmt.Fprintf(table, "%s\t%s\t%s\n", entry.Version, commit, entry.Date)
+		}
+		err := table.Flush()
+		return rendered.String(), err
+	case "json":
+		rendered, err := json.MarshalIndent(listed, "", "  ")
+		return string(rendered) + "\n", err
+	}
+	return "", fmt.Errorf("invalid output '%s' specified, expected one of %s", output, strings.Join(listOutputs, ", "))
+}
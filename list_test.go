@@ -0,0 +1,98 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/zephinzer/semver/semver"
+)
+
+type ListTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestList(t *testing.T) {
+	suite.Run(t, new(ListTestSuite))
+}
+
+// versions parses the :versions with the prefix 'v'
+func (s *ListTestSuite) versions(versions ...string) []semver.ISemver {
+	parsed := make([]semver.ISemver, 0)
+	for _, version := range versions {
+		parsed = append(parsed, semver.MustParse(version, "v"))
+	}
+	return parsed
+}
+
+// strings formats the :versions as strings
+func (s *ListTestSuite) strings(versions []semver.ISemver) []string {
+	formatted := make([]string, 0)
+	for _, version := range versions {
+		formatted = append(formatted, version.String())
+	}
+	return formatted
+}
+
+func (s *ListTestSuite) Test_listOptions_filter() {
+	versions := s.versions("v1.0.0", "v1.1.0-rc.0", "v1.1.0", "v1.2.0-beta.0", "v2.0.0")
+	for _, test := range []struct {
+		options  listOptions
+		expected []string
+	}{
+		{listOptions{}, []string{"v1.0.0", "v1.1.0-rc.0", "v1.1.0", "v1.2.0-beta.0", "v2.0.0"}},
+		{listOptions{since: "1.1.0"}, []string{"v1.1.0", "v1.2.0-beta.0", "v2.0.0"}},
+		{listOptions{since: "v1.1.0-rc.0"}, []string{"v1.1.0-rc.0", "v1.1.0", "v1.2.0-beta.0", "v2.0.0"}},
+		{listOptions{stable: true}, []string{"v1.0.0", "v1.1.0", "v2.0.0"}},
+		{listOptions{prereleases: true}, []string{"v1.1.0-rc.0", "v1.2.0-beta.0"}},
+		{listOptions{stable: true, limit: 2}, []string{"v1.1.0", "v2.0.0"}},
+		{listOptions{limit: 10}, []string{"v1.0.0", "v1.1.0-rc.0", "v1.1.0", "v1.2.0-beta.0", "v2.0.0"}},
+	} {
+		filtered, err := test.options.filter(versions, "v")
+		assert.Nil(s.T(), err)
+		assert.Equal(s.T(), test.expected, s.strings(filtered), "%+v", test.options)
+	}
+}
+
+func (s *ListTestSuite) Test_listOptions_filter_invalid() {
+	_, err := listOptions{stable: true, prereleases: true}.filter(nil, "v")
+	assert.EqualError(s.T(), err, "only one of --stable or --prereleases can be specified")
+	_, err = listOptions{limit: -1}.filter(nil, "v")
+	assert.EqualError(s.T(), err, "invalid limit '-1' specified, expected a positive number")
+	_, err = listOptions{since: "1.0"}.filter(nil, "v")
+	assert.Contains(s.T(), err.Error(), "invalid version '1.0' specified for --since")
+}
+
+func (s *ListTestSuite) Test_newListedVersions() {
+	s.commit("initial commit")
+	s.git("tag", "v1.0.0")
+	commit := s.git("rev-parse", "HEAD")
+	date := s.git("log", "-1", "--format=%cd", "--date=short")
+	listed, err := newListedVersions(s.versions("v1.0.0"), &GitLoader{}, true)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []listedVersion{{"v1.0.0", false, "refs/tags/v1.0.0", commit, date}}, listed)
+	listed, err = newListedVersions(s.versions("v1.0.0-rc.0"), &FileLoader{}, true)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []listedVersion{{Version: "v1.0.0-rc.0", Prerelease: true}}, listed)
+}
+
+func (s *ListTestSuite) Test_renderList() {
+	listed := []listedVersion{
+		{"v1.0.0", false, "refs/tags/v1.0.0", "9f2c1e0123456789", "2024-01-02"},
+		{"v1.1.0-rc.0", true, "refs/tags/v1.1.0-rc.0", "1a2b3c4d5e6f7a8b", "2024-02-03"},
+	}
+	output, err := renderList(listed, "table", false)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "v1.0.0\nv1.1.0-rc.0\n", output)
+	output, err = renderList(listed, "table", true)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "VERSION      COMMIT   DATE\nv1.0.0       9f2c1e0  2024-01-02\nv1.1.0-rc.0  1a2b3c4  2024-02-03\n", output)
+	output, err = renderList(listed[:1], "json", true)
+	assert.Nil(s.T(), err)
+	assert.JSONEq(s.T(), `[{"version": "v1.0.0", "prerelease": false, "tag": "refs/tags/v1.0.0", "commit": "9f2c1e0123456789", "date": "2024-01-02"}]`, output)
+	output, err = renderList([]listedVersion{}, "json", false)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "[]\n", output)
+	_, err = renderList(listed, "yaml", false)
+	assert.EqualError(s.T(), err, "invalid output 'yaml' specified, expected one of table, json")
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
+)
+
+// loadOptions defines how the version of the repository should be loaded
+type loadOptions struct {
+	mode    string
+	initial string
+	strict  bool
+}
+
+// getLoadOptions retrieves the load options from the flags of a command
+func getLoadOptions(c *cli.Context) loadOptions {
+	return loadOptions{
+		mode:    strings.ToLower(c.String("mode")),
+		initial: c.String("initial"),
+		strict:  c.Bool("strict"),
+	}
+}
+
+// load loads the version from the :source. if the source has no versions at
+// all, the initial version is returned unless strict mode is on
+func (options loadOptions) load(source VersionSource, prefix string) (*semver.Semver, error) {
+	if options.strict {
+		return semver.NewFrom(source.Load(options.mode, prefix))
+	}
+	initial, err := parseVersionArgument(options.initial, prefix)
+	if err != nil {
+		return nil, err
+	}
+	return semver.NewFrom(loadOr(source, initial, options.mode, prefix))
+}
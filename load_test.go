@@ -0,0 +1,49 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type LoadTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestLoad(t *testing.T) {
+	suite.Run(t, new(LoadTestSuite))
+}
+
+func (s *LoadTestSuite) Test_load_initialVersion() {
+	s.commit("initial commit")
+	version, err := loadOptions{mode: "latest", initial: "0.0.0"}.load(&GitLoader{}, "")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "0.0.0", version.String())
+	version, err = loadOptions{mode: "current", initial: "1.0.0"}.load(&GitLoader{}, "v")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "v1.0.0", version.String())
+}
+
+func (s *LoadTestSuite) Test_load_initialVersionIgnoredWithTags() {
+	s.commit("initial commit")
+	s.git("tag", "1.2.3")
+	s.commit("untagged commit")
+	version, err := loadOptions{mode: "latest", initial: "0.0.0"}.load(&GitLoader{}, "")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "1.2.3", version.String())
+	_, err = loadOptions{mode: "head", initial: "0.0.0"}.load(&GitLoader{}, "")
+	assert.EqualError(s.T(), err, "no semver tags point at HEAD")
+}
+
+func (s *LoadTestSuite) Test_load_strict() {
+	s.commit("initial commit")
+	_, err := loadOptions{mode: "latest", initial: "0.0.0", strict: true}.load(&GitLoader{}, "")
+	assert.Equal(s.T(), ErrNoTags, errorCause(err))
+}
+
+func (s *LoadTestSuite) Test_load_invalidInitialVersion() {
+	s.commit("initial commit")
+	_, err := loadOptions{mode: "latest", initial: "0.0"}.load(&GitLoader{}, "")
+	assert.NotNil(s.T(), err)
+}
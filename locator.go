@@ -0,0 +1,279 @@
+package main
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// versionLocator returns the start and end offsets of the version strings
+// within the :contents of a file, or nil if there are none
+type versionLocator func(contents string) [][]int
+
+// regexLocator locates all matches of the :pattern. the first submatch is
+// taken as the version if the pattern has one, otherwise the whole match is
+func regexLocator(pattern *regexp.Regexp) versionLocator {
+	return func(contents string) [][]int {
+		var locations [][]int
+		for _, match := range pattern.FindAllStringSubmatchIndex(contents, -1) {
+			if len(match) >= 4 && match[2] >= 0 {
+				locations = append(locations, []int{match[2], match[3]})
+			} else {
+				locations = append(locations, []int{match[0], match[1]})
+			}
+		}
+		return locations
+	}
+}
+
+// keyPathLocator locates the value at the dot-separated :keyPath within the
+// structured file at :path, the format of which is determined by its file
+// extension. nil is returned for unsupported formats
+func keyPathLocator(path string, keyPath string) versionLocator {
+	keys := strings.Split(keyPath, ".")
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return jsonKeyLocator(keys...)
+	case ".yaml", ".yml":
+		return yamlKeyLocator(keys...)
+	case ".toml":
+		return tomlKeyLocator(keys...)
+	}
+	return nil
+}
+
+// jsonKeyLocator locates the string value at the :keys within a JSON
+// document, leaving its formatting untouched
+func jsonKeyLocator(keys ...string) versionLocator {
+	return func(contents string) [][]int {
+		scanner := &jsonScanner{contents: contents}
+		if location := scanner.find(keys); location != nil {
+			return [][]int{location}
+		}
+		return nil
+	}
+}
+
+// jsonScanner walks a JSON document recording the offsets of values
+type jsonScanner struct {
+	contents string
+	offset   int
+}
+
+// find scans the value at the current offset, returning the offsets of the
+// string value at the :keys within it
+func (scanner *jsonScanner) find(keys []string) []int {
+	scanner.skipWhitespace()
+	if scanner.offset >= len(scanner.contents) {
+		return nil
+	}
+	switch scanner.contents[scanner.offset] {
+	case '{':
+		return scanner.findInObject(keys)
+	case '[':
+		scanner.skipArray()
+	case '"':
+		start, end := scanner.scanString()
+		if len(keys) == 0 && end > start {
+			return []int{start + 1, end - 1}
+		}
+	default:
+		scanner.skipLiteral()
+	}
+	return nil
+}
+
+// findInObject scans the object at the current offset for the :keys
+func (scanner *jsonScanner) findInObject(keys []string) []int {
+	var found []int
+	scanner.offset++
+	for scanner.offset < len(scanner.contents) {
+		scanner.skipWhitespace()
+		if scanner.offset >= len(scanner.contents) {
+			return found
+		}
+		switch scanner.contents[scanner.offset] {
+		case '}':
+			scanner.offset++
+			return found
+		case ',':
+			scanner.offset++
+			continue
+		case '"':
+		default:
+			return found
+		}
+		start, end := scanner.scanString()
+		key := scanner.contents[start+1 : end-1]
+		scanner.skipWhitespace()
+		if scanner.offset < len(scanner.contents) && scanner.contents[scanner.offset] == ':' {
+			scanner.offset++
+		}
+		if len(keys) > 0 && key == keys[0] && found == nil {
+			found = scanner.find(keys[1:])
+		} else {
+			scanner.find(nil)
+		}
+	}
+	return found
+}
+
+// skipArray skips over the array at the current offset
+func (scanner *jsonScanner) skipArray() {
+	scanner.offset++
+	for scanner.offset < len(scanner.contents) {
+		scanner.skipWhitespace()
+		if scanner.offset >= len(scanner.contents) {
+			return
+		}
+		switch scanner.contents[scanner.offset] {
+		case ']':
+			scanner.offset++
+			return
+		case ',':
+			scanner.offset++
+		default:
+			scanner.find(nil)
+		}
+	}
+}
+
+// scanString skips over the string at the current offset, returning the
+// offsets of its opening and closing quotes
+func (scanner *jsonScanner) scanString() (int, int) {
+	start := scanner.offset
+	scanner.offset++
+	for scanner.offset < len(scanner.contents) {
+		switch scanner.contents[scanner.offset] {
+		case '\\':
+			scanner.offset += 2
+			continue
+		case '"':
+			scanner.offset++
+			return start, scanner.offset
+		}
+		scanner.offset++
+	}
+	return start, scanner.offset
+}
+
+// skipLiteral skips over the number, boolean or null at the current offset
+func (scanner *jsonScanner) skipLiteral() {
+	start := scanner.offset
+	for scanner.offset < len(scanner.contents) && !strings.ContainsRune(",}] \t\r\n", rune(scanner.contents[scanner.offset])) {
+		scanner.offset++
+	}
+	if scanner.offset == start {
+		scanner.offset++
+	}
+}
+
+// skipWhitespace advances the offset past any whitespace
+func (scanner *jsonScanner) skipWhitespace() {
+	for scanner.offset < len(scanner.contents) && strings.ContainsRune(" \t\r\n", rune(scanner.contents[scanner.offset])) {
+		scanner.offset++
+	}
+}
+
+// yamlKey matches a line of a YAML block mapping, capturing its indentation,
+// key and value
+var yamlKey = regexp.MustCompile(`^([ \t]*)(?:"([^"]*)"|'([^']*)'|([^\s"'#:-][^#:]*?))[ \t]*:(?:[ \t]+(.*?))?[ \t]*$`)
+
+// yamlKeyLocator locates the scalar value at the :keys within a YAML
+// document made up of block mappings
+func yamlKeyLocator(keys ...string) versionLocator {
+	return func(contents string) [][]int {
+		type yamlParent struct {
+			indent int
+			key    string
+		}
+		var parents []yamlParent
+		offset := 0
+		for _, line := range strings.SplitAfter(contents, "\n") {
+			lineOffset := offset
+			offset += len(line)
+			line = strings.TrimRight(line, "\r\n")
+			match := yamlKey.FindStringSubmatchIndex(line)
+			if match == nil {
+				continue
+			}
+			indent := match[3] - match[2]
+			key := ""
+			for group := 2; group <= 4; group++ {
+				if match[group*2] >= 0 {
+					key = line[match[group*2]:match[group*2+1]]
+				}
+			}
+			for len(parents) > 0 && parents[len(parents)-1].indent >= indent {
+				parents = parents[:len(parents)-1]
+			}
+			parents = append(parents, yamlParent{indent, key})
+			if len(parents) != len(keys) || match[10] < 0 {
+				continue
+			}
+			matched := true
+			for index, parent := range parents {
+				matched = matched && parent.key == keys[index]
+			}
+			if !matched {
+				continue
+			}
+			start, end := match[10], match[11]
+			if commentIndex := strings.Index(line[start:end], " #"); commentIndex >= 0 {
+				end = start + len(strings.TrimRight(line[start:start+commentIndex], " \t"))
+			}
+			if end-start >= 2 && (line[start] == '"' || line[start] == '\'') && line[end-1] == line[start] {
+				start, end = start+1, end-1
+			}
+			if end > start {
+				return [][]int{{lineOffset + start, lineOffset + end}}
+			}
+		}
+		return nil
+	}
+}
+
+// tomlKeyLocator locates the string value at the :keys within a TOML
+// document, where all but the last key name the table holding the value
+func tomlKeyLocator(keys ...string) versionLocator {
+	table := strings.Join(keys[:len(keys)-1], ".")
+	pattern := regexp.MustCompile(`(?m)^[ \t]*` + regexp.QuoteMeta(keys[len(keys)-1]) + `[ \t]*=[ \t]*(?:"([^"]*)"|'([^']*)')`)
+	return func(contents string) [][]int {
+		offset, length := 0, len(contents)
+		if len(table) > 0 {
+			offset, length = findTOMLTable(contents, table)
+		} else if next := tomlTableHeader.FindStringIndex(contents); next != nil {
+			length = next[0]
+		}
+		if offset < 0 {
+			return nil
+		}
+		match := pattern.FindStringSubmatchIndex(contents[offset : offset+length])
+		if match == nil {
+			return nil
+		}
+		if match[2] < 0 {
+			return [][]int{{offset + match[4], offset + match[5]}}
+		}
+		return [][]int{{offset + match[2], offset + match[3]}}
+	}
+}
+
+// tomlTableHeader matches the start of a TOML table header
+var tomlTableHeader = regexp.MustCompile(`(?m)^[ \t]*\[`)
+
+// findTOMLTable returns the offset and length of the body of the TOML table
+// named :table within the :contents, or an offset of -1 if there is none
+func findTOMLTable(contents string, table string) (int, int) {
+	header := regexp.MustCompile(`(?m)^[ \t]*\[` + regexp.QuoteMeta(table) + `\][ \t]*(#.*)?$`)
+	location := header.FindStringIndex(contents)
+	if location == nil {
+		return -1, 0
+	}
+	offset := location[1]
+	next := tomlTableHeader.FindStringIndex(contents[offset:])
+	if next == nil {
+		return offset, len(contents) - offset
+	}
+	return offset, next[0]
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocatorTestSuite struct {
+	suite.Suite
+}
+
+func TestLocator(t *testing.T) {
+	suite.Run(t, new(LocatorTestSuite))
+}
+
+// located returns the strings at the :locations of the :contents
+func (s *LocatorTestSuite) located(contents string, locations [][]int) []string {
+	var values []string
+	for _, location := range locations {
+		values = append(values, contents[location[0]:location[1]])
+	}
+	return values
+}
+
+func (s *LocatorTestSuite) Test_regexLocator() {
+	contents := "LABEL version=\"1.2.3\"\nENV VERSION 1.2.3\n"
+	locate := regexLocator(regexp.MustCompile(`version="([^"]*)"`))
+	assert.Equal(s.T(), []string{"1.2.3"}, s.located(contents, locate(contents)))
+	locate = regexLocator(regexp.MustCompile(`\d+\.\d+\.\d+`))
+	assert.Equal(s.T(), []string{"1.2.3", "1.2.3"}, s.located(contents, locate(contents)))
+}
+
+func (s *LocatorTestSuite) Test_jsonKeyLocator() {
+	contents := `{
+  "name": "app",
+  "scripts": {"version": "echo \"9.9.9\""},
+  "list": [{"version": "8.8.8"}, 1, true, null],
+  "engines": {"node": ">=10"},
+  "app": {"release": {"version": "1.2.3"}},
+  "version": "2.0.0"
+}`
+	assert.Equal(s.T(), []string{"2.0.0"}, s.located(contents, jsonKeyLocator("version")(contents)))
+	assert.Equal(s.T(), []string{"1.2.3"}, s.located(contents, jsonKeyLocator("app", "release", "version")(contents)))
+	assert.Nil(s.T(), jsonKeyLocator("app", "version")(contents))
+	assert.Nil(s.T(), jsonKeyLocator("version")("{]"))
+}
+
+func (s *LocatorTestSuite) Test_yamlKeyLocator() {
+	contents := `apiVersion: v2
+name: app # the name
+appVersion: "9.9.9"
+image:
+  tag: '1.2.3' # the image tag
+  repository: example.com/app
+version: 2.0.0
+`
+	assert.Equal(s.T(), []string{"2.0.0"}, s.located(contents, yamlKeyLocator("version")(contents)))
+	assert.Equal(s.T(), []string{"1.2.3"}, s.located(contents, yamlKeyLocator("image", "tag")(contents)))
+	assert.Nil(s.T(), yamlKeyLocator("tag")(contents))
+	assert.Nil(s.T(), yamlKeyLocator("image")(contents))
+}
+
+func (s *LocatorTestSuite) Test_tomlKeyLocator() {
+	contents := `version = "0.1.0"
+
+[dependencies]
+serde = { version = "9.9.9" }
+
+[package]
+name = "app"
+version = '1.2.3'
+`
+	assert.Equal(s.T(), []string{"0.1.0"}, s.located(contents, tomlKeyLocator("version")(contents)))
+	assert.Equal(s.T(), []string{"1.2.3"}, s.located(contents, tomlKeyLocator("package", "version")(contents)))
+	assert.Nil(s.T(), tomlKeyLocator("workspace", "version")(contents))
+}
+
+func (s *LocatorTestSuite) Test_keyPathLocator() {
+	assert.NotNil(s.T(), keyPathLocator("package.json", "version"))
+	assert.NotNil(s.T(), keyPathLocator("chart/Chart.YAML", "version"))
+	assert.NotNil(s.T(), keyPathLocator("values.yml", "image.tag"))
+	assert.NotNil(s.T(), keyPathLocator("Cargo.toml", "package.version"))
+	assert.Nil(s.T(), keyPathLocator("Dockerfile", "version"))
+}
@@ -21,8 +21,16 @@ func bootstrap(app *cli.App) {
 	app.Usage = "go forth and semver"
 	app.Commands = commands(
 		getBumpCommand,
+		getCompareCommand,
+		getChangelogCommand,
+		getConfigCommand,
+		getDiffCommand,
 		getGetCommand,
+		getLintCommand,
+		getListCommand,
+		getSatisfiesCommand,
 		getSetCommand,
+		getValidateCommand,
 		getVersionCommand,
 	)
 	app.Version = fmt.Sprintf("%s-%s", Version, Commit)
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/zephinzer/semver/semver"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// outputFormats lists the formats supported by --output
+var outputFormats = []string{"json", "yaml", "env"}
+
+// versionInfo holds all fields of a version as printed by --output
+type versionInfo struct {
+	Prefix     string   `json:"prefix" yaml:"prefix"`
+	Major      int      `json:"major" yaml:"major"`
+	Minor      int      `json:"minor" yaml:"minor"`
+	Patch      int      `json:"patch" yaml:"patch"`
+	Prerelease []string `json:"prerelease" yaml:"prerelease"`
+	Build      string   `json:"build" yaml:"build"`
+	Version    string   `json:"version" yaml:"version"`
+	// Tag is the ref of the tag of the version, empty if it is not tagged
+	Tag string `json:"tag" yaml:"tag"`
+	// Commit is the SHA of the tagged commit, empty if it is not tagged
+	Commit string `json:"commit" yaml:"commit"`
+	// Distance is the number of commits made since the tagged commit, nil
+	// if the version is not tagged
+	Distance *int `json:"distance" yaml:"distance"`
+}
+
+// newVersionInfo collects the fields of the :version, which is derived from
+// the version :loaded from the :source. the tag fields describe the tag of
+// the loaded version and are only filled in for git sources
+func newVersionInfo(version semver.ISemver, loaded semver.ISemver, source VersionSource) (versionInfo, error) {
+	info := versionInfo{
+		Prefix:     version.GetPrefix(),
+		Major:      version.GetMajorInt(),
+		Minor:      version.GetMinorInt(),
+		Patch:      version.GetPatchInt(),
+		Prerelease: []string{},
+		Build:      version.GetBuild(),
+		Version:    version.String(),
+	}
+	if label := version.GetLabel(); len(label) > 0 {
+		info.Prerelease = strings.Split(label, ".")
+	}
+	if _, ok := source.(*GitLoader); !ok {
+		return info, nil
+	}
+	tag := "refs/tags/" + loaded.String()
+	commit, err := gitRevParse(tag)
+	if err != nil {
+		// the version is not tagged when it is the initial version
+		return info, nil
+	}
+	distance, err := gitRevListCount(tag + "..HEAD")
+	if err != nil {
+		return info, err
+	}
+	info.Tag = tag
+	info.Commit = commit
+	info.Distance = &distance
+	return info, nil
+}
+
+// renderOutput renders the :info in the :format, one of outputFormats
+func renderOutput(info versionInfo, format string) (string, error) {
+	switch format {
+	case "json":
+		output, err := json.MarshalIndent(info, "", "  ")
+		return string(output) + "\n", err
+	case "yaml":
+		output, err := yaml.Marshal(info)
+		return string(output), err
+	case "env":
+		distance := ""
+		if info.Distance != nil {
+			distance = fmt.Sprint(*info.Distance)
+		}
+		return strings.Join([]string{
+			"SEMVER_PREFIX=" + info.Prefix,
+			fmt.Sprintf("SEMVER_MAJOR=%d", info.Major),
+			fmt.Sprintf("SEMVER_MINOR=%d", info.Minor),
+			fmt.Sprintf("SEMVER_PATCH=%d", info.Patch),
+			"SEMVER_PRERELEASE=" + strings.Join(info.Prerelease, "."),
+			"SEMVER_BUILD=" + info.Build,
+			"SEMVER_VERSION=" + info.Version,
+			"SEMVER_TAG=" + info.Tag,
+			"SEMVER_COMMIT=" + info.Commit,
+			"SEMVER_DISTANCE=" + distance,
+		}, "\n") + "\n", nil
+	}
+	return "", fmt.Errorf("invalid output '%s' specified, expected one of %s", format, strings.Join(outputFormats, ", "))
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/zephinzer/semver/semver"
+)
+
+type OutputTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestOutput(t *testing.T) {
+	suite.Run(t, new(OutputTestSuite))
+}
+
+func (s *OutputTestSuite) Test_newVersionInfo() {
+	s.commit("initial commit")
+	s.git("tag", "--annotate", "--message", "Release", "v1.2.3-rc.1+build.5")
+	commit := s.git("rev-parse", "HEAD")
+	s.commit("second commit")
+	s.commit("third commit")
+	info, err := newVersionInfo(semver.MustParse("v1.2.3-rc.1+build.5", "v"), semver.MustParse("v1.2.3-rc.1+build.5", "v"), &GitLoader{})
+	assert.Nil(s.T(), err)
+	distance := 2
+	assert.Equal(s.T(), versionInfo{
+		Prefix:     "v",
+		Major:      1,
+		Minor:      2,
+		Patch:      3,
+		Prerelease: []string{"rc", "1"},
+		Build:      "build.5",
+		Version:    "v1.2.3-rc.1+build.5",
+		Tag:        "refs/tags/v1.2.3-rc.1+build.5",
+		Commit:     commit,
+		Distance:   &distance,
+	}, info)
+}
+
+func (s *OutputTestSuite) Test_newVersionInfo_untagged() {
+	s.commit("initial commit")
+	for _, source := range []VersionSource{&GitLoader{}, &FileLoader{}} {
+		info, err := newVersionInfo(semver.MustParse("0.0.0"), semver.MustParse("0.0.0"), source)
+		assert.Nil(s.T(), err)
+		assert.Equal(s.T(), []string{}, info.Prerelease)
+		assert.Empty(s.T(), info.Tag)
+		assert.Empty(s.T(), info.Commit)
+		assert.Nil(s.T(), info.Distance)
+	}
+}
+
+func (s *OutputTestSuite) Test_renderOutput() {
+	distance := 0
+	info := versionInfo{Major: 1, Minor: 2, Patch: 3, Prerelease: []string{"rc", "1"}, Version: "1.2.3-rc.1", Tag: "refs/tags/1.2.3-rc.1", Commit: "abc", Distance: &distance}
+	output, err := renderOutput(info, "json")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), `{
+  "prefix": "",
+  "major": 1,
+  "minor": 2,
+  "patch": 3,
+  "prerelease": [
+    "rc",
+    "1"
+  ],
+  "build": "",
+  "version": "1.2.3-rc.1",
+  "tag": "refs/tags/1.2.3-rc.1",
+  "commit": "abc",
+  "distance": 0
+}
+`, output)
+	output, err = renderOutput(info, "yaml")
+	assert.Nil(s.T(), err)
+	assert.Contains(s.T(), output, "prerelease:\n- rc\n- \"1\"\n")
+	assert.Contains(s.T(), output, "distance: 0\n")
+	output, err = renderOutput(info, "env")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), `SEMVER_PREFIX=
+SEMVER_MAJOR=1
+SEMVER_MINOR=2
+SEMVER_PATCH=3
+SEMVER_PRERELEASE=rc.1
+SEMVER_BUILD=
+SEMVER_VERSION=1.2.3-rc.1
+SEMVER_TAG=refs/tags/1.2.3-rc.1
+SEMVER_COMMIT=abc
+SEMVER_DISTANCE=0
+`, output)
+	_, err = renderOutput(info, "xml")
+	assert.EqualError(s.T(), err, "invalid output 'xml' specified, expected one of json, yaml, env")
+}
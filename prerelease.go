@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zephinzer/semver/semver"
+)
+
+// defaultChannels lists the prerelease channels in ascending order of
+// maturity when no channels are specified
+const defaultChannels = "alpha,beta,rc"
+
+// bumpTypes lists the bump types which can be specified for `bump`
+var bumpTypes = []string{"major", "minor", "patch", "label", "premajor", "preminor", "prepatch", "prerelease", "promote", "release"}
+
+// prereleaseChannels lists the prerelease channels in ascending order of
+// maturity, prereleases can only move to a later channel
+type prereleaseChannels []string
+
+// parseChannels parses a comma-separated list of channels such as
+// "alpha,beta,rc"
+func parseChannels(channels string) prereleaseChannels {
+	var parsedChannels prereleaseChannels
+	for _, channel := range strings.Split(channels, ",") {
+		if channel = strings.TrimSpace(channel); len(channel) > 0 {
+			parsedChannels = append(parsedChannels, channel)
+		}
+	}
+	return parsedChannels
+}
+
+// rank returns the position of the :channel, or -1 if it is not one of
+// the channels
+func (channels prereleaseChannels) rank(channel string) int {
+	for rank, knownChannel := range channels {
+		if knownChannel == channel {
+			return rank
+		}
+	}
+	return -1
+}
+
+// checkBump returns an error if the :bumpType to the :channel is not a
+// valid move from the :version
+func (channels prereleaseChannels) checkBump(bumpType string, version semver.ISemver, channel string) error {
+	current := version.GetLabelString()
+	switch bumpType {
+	case "premajor", "preminor", "prepatch":
+		return channels.checkChannel(bumpType, channel)
+	case "prerelease", "release":
+		if len(version.GetLabel()) == 0 {
+			return fmt.Errorf("cannot bump %s as %s is not a prerelease, use prepatch, preminor or premajor to start one", bumpType, version)
+		}
+	case "promote":
+		if len(version.GetLabel()) == 0 {
+			return fmt.Errorf("cannot promote %s as it is not a prerelease, use prepatch, preminor or premajor to start one", version)
+		}
+		if err := channels.checkChannel(bumpType, channel); err != nil {
+			return err
+		}
+		if channels.rank(current) < 0 {
+			return fmt.Errorf("cannot promote %s as its channel '%s' is not one of %s", version, current, strings.Join(channels, ", "))
+		}
+		if channels.rank(channel) <= channels.rank(current) {
+			return fmt.Errorf("cannot promote %s from '%s' to '%s' as channels only move forward through %s", version, current, channel, strings.Join(channels, ", "))
+		}
+	case "label":
+		if len(version.GetLabel()) > 0 && channels.rank(current) >= 0 && channels.rank(channel) >= 0 && channels.rank(channel) < channels.rank(current) {
+			return fmt.Errorf("cannot move %s from '%s' back to '%s' as channels only move forward through %s", version, current, channel, strings.Join(channels, ", "))
+		}
+	}
+	return nil
+}
+
+// checkChannel returns an error if the :channel specified for the
+// :bumpType is not one of the channels
+func (channels prereleaseChannels) checkChannel(bumpType string, channel string) error {
+	if len(channel) == 0 {
+		return fmt.Errorf("a channel must be specified to bump %s, one of %s", bumpType, strings.Join(channels, ", "))
+	}
+	if channels.rank(channel) < 0 {
+		return fmt.Errorf("invalid channel '%s' specified, expected one of %s", channel, strings.Join(channels, ", "))
+	}
+	return nil
+}
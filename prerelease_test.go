@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/zephinzer/semver/semver"
+)
+
+type PrereleaseTestSuite struct {
+	suite.Suite
+	channels prereleaseChannels
+}
+
+func TestPrerelease(t *testing.T) {
+	suite.Run(t, new(PrereleaseTestSuite))
+}
+
+func (s *PrereleaseTestSuite) SetupTest() {
+	s.channels = parseChannels(defaultChannels)
+}
+
+func (s *PrereleaseTestSuite) Test_parseChannels() {
+	assert.Equal(s.T(), prereleaseChannels{"alpha", "beta", "rc"}, s.channels)
+	assert.Equal(s.T(), prereleaseChannels{"dev", "rc"}, parseChannels(" dev, ,rc "))
+}
+
+func (s *PrereleaseTestSuite) Test_checkBump_start() {
+	stable := semver.MustParse("1.4.2")
+	assert.Nil(s.T(), s.channels.checkBump("premajor", stable, "rc"))
+	assert.EqualError(s.T(), s.channels.checkBump("preminor", stable, ""), "a channel must be specified to bump preminor, one of alpha, beta, rc")
+	assert.EqualError(s.T(), s.channels.checkBump("prepatch", stable, "gamma"), "invalid channel 'gamma' specified, expected one of alpha, beta, rc")
+}
+
+func (s *PrereleaseTestSuite) Test_checkBump_prerelease() {
+	assert.Nil(s.T(), s.channels.checkBump("prerelease", semver.MustParse("2.0.0-rc.1"), ""))
+	assert.Nil(s.T(), s.channels.checkBump("release", semver.MustParse("2.0.0-rc.1"), ""))
+	assert.EqualError(s.T(), s.channels.checkBump("prerelease", semver.MustParse("2.0.0"), ""), "cannot bump prerelease as 2.0.0 is not a prerelease, use prepatch, preminor or premajor to start one")
+	assert.EqualError(s.T(), s.channels.checkBump("release", semver.MustParse("2.0.0"), ""), "cannot bump release as 2.0.0 is not a prerelease, use prepatch, preminor or premajor to start one")
+}
+
+func (s *PrereleaseTestSuite) Test_checkBump_promote() {
+	beta := semver.MustParse("2.0.0-beta.3")
+	assert.Nil(s.T(), s.channels.checkBump("promote", beta, "rc"))
+	assert.EqualError(s.T(), s.channels.checkBump("promote", beta, "alpha"), "cannot promote 2.0.0-beta.3 from 'beta' to 'alpha' as channels only move forward through alpha, beta, rc")
+	assert.EqualError(s.T(), s.channels.checkBump("promote", beta, "beta"), "cannot promote 2.0.0-beta.3 from 'beta' to 'beta' as channels only move forward through alpha, beta, rc")
+	assert.EqualError(s.T(), s.channels.checkBump("promote", semver.MustParse("2.0.0-nightly.1"), "rc"), "cannot promote 2.0.0-nightly.1 as its channel 'nightly' is not one of alpha, beta, rc")
+	assert.EqualError(s.T(), s.channels.checkBump("promote", semver.MustParse("2.0.0"), "rc"), "cannot promote 2.0.0 as it is not a prerelease, use prepatch, preminor or premajor to start one")
+}
+
+func (s *PrereleaseTestSuite) Test_checkBump_label() {
+	rc := semver.MustParse("2.0.0-rc.1")
+	assert.Nil(s.T(), s.channels.checkBump("label", rc, "rc"))
+	assert.Nil(s.T(), s.channels.checkBump("label", rc, "nightly"))
+	assert.Nil(s.T(), s.channels.checkBump("label", semver.MustParse("2.0.0"), "alpha"))
+	assert.EqualError(s.T(), s.channels.checkBump("label", rc, "beta"), "cannot move 2.0.0-rc.1 from 'rc' back to 'beta' as channels only move forward through alpha, beta, rc")
+}
@@ -0,0 +1,379 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
+)
+
+// defaultFileLocators holds the locators used for bump files which are not
+// the default file of a file-based source, by file name
+var defaultFileLocators = map[string][]versionLocator{
+	"Dockerfile": {regexLocator(regexp.MustCompile(`(?m)^LABEL\b.*\bversion="?([^"\s]+)"?`))},
+}
+
+// releaseOptions defines the changes made to the project when releasing a
+// new version
+type releaseOptions struct {
+	// files are the bump files in which the version is to be updated
+	files []string
+	// dryRun previews the changes instead of making them
+	dryRun bool
+	// branch is the branch checked against the branch policies, defaults to
+	// the current branch
+	branch string
+	// branches are the branch policies releases are subject to
+	branches []branchPolicy
+	// paths limits the commits considered for the release to those changing
+	// any of them, such as the directory of a component
+	paths []string
+}
+
+// getReleaseOptions retrieves the release options from the flags of a
+// command and the branch policies and components of the :configuration
+func getReleaseOptions(c *cli.Context, configuration config) releaseOptions {
+	return releaseOptions{
+		files:    c.StringSlice("file"),
+		dryRun:   c.Bool("dry-run"),
+		branch:   c.String("branch"),
+		branches: configuration.Branches,
+		paths:    getComponentOptions(c, configuration).paths(),
+	}
+}
+
+// checkBranch returns an error if the branch policies do not allow releases
+// of the :bumpType from the branch
+func (options releaseOptions) checkBranch(bumpType string) error {
+	if len(options.branches) == 0 {
+		return nil
+	}
+	branch := options.branch
+	if len(branch) == 0 {
+		var err error
+		if branch, err = gitCurrentBranch(); err != nil {
+			return err
+		}
+		if branch == "HEAD" {
+			return fmt.Errorf("the current branch could not be determined as HEAD is detached, specify it using --branch")
+		}
+	}
+	for _, policy := range options.branches {
+		if matched, _ := path.Match(policy.Name, branch); !matched {
+			continue
+		}
+		if len(policy.Bumps) > 0 && !sliceContainsString(policy.Bumps, bumpType) {
+			return fmt.Errorf("%s releases are not allowed on branch '%s', expected one of %s", bumpType, branch, strings.Join(policy.Bumps, ", "))
+		}
+		return nil
+	}
+	return fmt.Errorf("releases are not allowed on branch '%s'", branch)
+}
+
+// bumpFile is a file in which the version is updated on release
+type bumpFile struct {
+	path     string
+	locators []versionLocator
+}
+
+// parseBumpFile parses a bump file :spec, which is one of:
+//   - "<path>": the version is located as it is by the source reading the
+//     file by default, eg. the "version" of a package.json
+//   - "<path>=<regex>": all matches of the regex are replaced, or only its
+//     first submatch if it has one
+//   - "<path>:<key.path>": the value at the key path of a JSON, YAML or
+//     TOML file is replaced
+func parseBumpFile(spec string) (bumpFile, error) {
+	if index := strings.Index(spec, "="); index >= 0 {
+		pattern, err := regexp.Compile(spec[index+1:])
+		if err != nil {
+			return bumpFile{}, fmt.Errorf("invalid pattern in bump file '%s': %s", spec, err)
+		}
+		return bumpFile{spec[:index], []versionLocator{regexLocator(pattern)}}, nil
+	}
+	if index := strings.LastIndex(spec, ":"); index >= 0 {
+		path := spec[:index]
+		locator := keyPathLocator(path, spec[index+1:])
+		if locator == nil {
+			return bumpFile{}, fmt.Errorf("invalid bump file '%s', key paths are only supported for JSON, YAML and TOML files", spec)
+		}
+		return bumpFile{path, []versionLocator{locator}}, nil
+	}
+	name := filepath.Base(spec)
+	if locators, ok := fileSourceLocators[name]; ok {
+		return bumpFile{spec, locators}, nil
+	}
+	if locators, ok := defaultFileLocators[name]; ok {
+		return bumpFile{spec, locators}, nil
+	}
+	return bumpFile{}, fmt.Errorf("invalid bump file '%s', specify how to find the version with '<path>=<regex>' or '<path>:<key.path>'", spec)
+}
+
+// update returns the change replacing all located versions in the file
+// with the :version
+func (file bumpFile) update(version semver.ISemver) (fileChange, error) {
+	contents, err := ioutil.ReadFile(file.path)
+	if err != nil {
+		return fileChange{}, err
+	}
+	for _, locator := range file.locators {
+		if locations := locator(string(contents)); len(locations) > 0 {
+			updated := replaceVersions(string(contents), locations, version)
+			return fileChange{file.path, string(contents), updated}, nil
+		}
+	}
+	return fileChange{}, fmt.Errorf("no version found in %s", file.path)
+}
+
+// replaceVersions replaces the versions at the :locations of the :contents
+// with the :version. the prefix is only written where the version being
+// replaced had one
+func replaceVersions(contents string, locations [][]int, version semver.ISemver) string {
+	var updated strings.Builder
+	previous := 0
+	for _, location := range locations {
+		written := version.String()
+		if prefix := version.GetPrefix(); !strings.HasPrefix(contents[location[0]:location[1]], prefix) {
+			written = strings.TrimPrefix(written, prefix)
+		}
+		updated.WriteString(contents[previous:location[0]])
+		updated.WriteString(written)
+		previous = location[1]
+	}
+	updated.WriteString(contents[previous:])
+	return updated.String()
+}
+
+// fileChange holds the contents of a file before and after a change
+type fileChange struct {
+	path   string
+	before string
+	after  string
+}
+
+// release updates the bump files and the :changelog for the :version
+// described by the :change, commits them, and writes the version to the
+// :source if the branch policies allow it. file-based sources are written
+// along with the bump files so they are part of the same commit. with a dry
+// run, the changes are printed instead
+func (options releaseOptions) release(source VersionSource, version semver.ISemver, change versionChange, changelog string) error {
+	if err := options.checkBranch(change.BumpType); err != nil {
+		return err
+	}
+	var changes []fileChange
+	for _, spec := range options.files {
+		file, err := parseBumpFile(spec)
+		if err != nil {
+			return err
+		}
+		fileUpdate, err := file.update(version)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, fileUpdate)
+	}
+	if len(changelog) > 0 {
+		changelogUpdate, err := releaseChangelog(changelog, version, options.paths...)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, changelogUpdate)
+	}
+	commit := len(changes) > 0
+	fileSource, isFileSource := source.(*FileLoader)
+	if isFileSource && !changesPath(changes, fileSource.path) {
+		sourceUpdate, err := fileSource.update(version)
+		if err != nil {
+			return err
+		}
+		changes = append([]fileChange{sourceUpdate}, changes...)
+	}
+	if options.dryRun {
+		for _, fileUpdate := range changes {
+			fmt.Print(renderDiff(fileUpdate))
+		}
+		fmt.Printf("dry run: %s -> %s was not released\n", change.Previous, change.Next)
+		return nil
+	}
+	var paths []string
+	for _, fileUpdate := range changes {
+		if err := ioutil.WriteFile(fileUpdate.path, []byte(fileUpdate.after), 0644); err != nil {
+			return err
+		}
+		paths = append(paths, fileUpdate.path)
+	}
+	if commit {
+		if _, err := gitAdd(paths...); err != nil {
+			return err
+		}
+		if _, err := gitCommit("chore(release): " + version.String()); err != nil {
+			return err
+		}
+	}
+	if isFileSource {
+		return nil
+	}
+	return source.Write(version, change)
+}
+
+// changesPath returns true if one of the :changes is to the file at :path
+func changesPath(changes []fileChange, path string) bool {
+	for _, fileUpdate := range changes {
+		if filepath.Clean(fileUpdate.path) == filepath.Clean(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// releaseChangelog returns the change prepending the changelog entry for
+// the :version to the changelog at :path, with the commits limited to
+// those changing any of the :paths if specified
+func releaseChangelog(path string, version semver.ISemver, paths ...string) (fileChange, error) {
+	revisionRange, err := changelogRange("", "HEAD", version.GetPrefix())
+	if err != nil {
+		return fileChange{}, err
+	}
+	messages, err := gitLog(revisionRange, paths...)
+	if err != nil {
+		return fileChange{}, err
+	}
+	entry := renderChangelog(version.String(), time.Now().UTC().Format("2006-01-02"), messages)
+	before, after, err := prependedChangelog(path, entry)
+	if err != nil {
+		return fileChange{}, err
+	}
+	return fileChange{path, before, after}, nil
+}
+
+// renderDiff renders the :change as a unified diff with three lines of
+// context around each hunk
+func renderDiff(change fileChange) string {
+	const context = 3
+	lines := diffLines(splitDiffLines(change.before), splitDiffLines(change.after))
+	var diff strings.Builder
+	diff.WriteString(fmt.Sprintf("--- a/%s\n+++ b/%s\n", change.path, change.path))
+	for start := 0; start < len(lines); {
+		if lines[start].operation == ' ' {
+			start++
+			continue
+		}
+		first := start - context
+		if first < 0 {
+			first = 0
+		}
+		last, unchanged := start, 0
+		for end := start; end < len(lines) && unchanged <= 2*context; end++ {
+			if lines[end].operation == ' ' {
+				unchanged++
+			} else {
+				last, unchanged = end, 0
+			}
+		}
+		last += context
+		if last >= len(lines) {
+			last = len(lines) - 1
+		}
+		removed, added := 0, 0
+		for _, line := range lines[first : last+1] {
+			if line.operation != '+' {
+				removed++
+			}
+			if line.operation != '-' {
+				added++
+			}
+		}
+		diff.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", lines[first].i+1, removed, lines[first].j+1, added))
+		for _, line := range lines[first : last+1] {
+			diff.WriteString(string(line.operation) + strings.TrimSuffix(line.text, "\n") + "\n")
+		}
+		start = last + 1
+	}
+	return diff.String()
+}
+
+// diffLine is a line of a diff, with the :operation ' ' for unchanged
+// lines, '-' for removed lines and '+' for added lines, and :i and :j the
+// indexes of the line before and after the change
+type diffLine struct {
+	operation byte
+	text      string
+	i, j      int
+}
+
+// diffLines returns the shortest edit script turning the lines :before into
+// :after, using the algorithm of Myers which takes time and space growing
+// with the number of changed lines rather than the length of the file
+func diffLines(before []string, after []string) []diffLine {
+	offset := len(before) + len(after) + 1
+	furthest := make([]int, 2*offset+1)
+	var trace [][]int
+	for edits := 0; edits < offset; edits++ {
+		done := false
+		for diagonal := -edits; diagonal <= edits && !done; diagonal += 2 {
+			var x int
+			if diagonal == -edits || (diagonal != edits && furthest[offset+diagonal-1] < furthest[offset+diagonal+1]) {
+				x = furthest[offset+diagonal+1]
+			} else {
+				x = furthest[offset+diagonal-1] + 1
+			}
+			y := x - diagonal
+			for x < len(before) && y < len(after) && before[x] == after[y] {
+				x, y = x+1, y+1
+			}
+			furthest[offset+diagonal] = x
+			done = x >= len(before) && y >= len(after)
+		}
+		trace = append(trace, append([]int(nil), furthest[offset-edits:offset+edits+1]...))
+		if done {
+			break
+		}
+	}
+	var lines []diffLine
+	x, y := len(before), len(after)
+	for edits := len(trace) - 1; edits > 0; edits-- {
+		previous := trace[edits-1]
+		diagonal := x - y
+		previousDiagonal := diagonal - 1
+		if diagonal == -edits || (diagonal != edits && previous[diagonal-1+edits-1] < previous[diagonal+1+edits-1]) {
+			previousDiagonal = diagonal + 1
+		}
+		previousX := previous[previousDiagonal+edits-1]
+		previousY := previousX - previousDiagonal
+		for x > previousX && y > previousY {
+			x, y = x-1, y-1
+			lines = append(lines, diffLine{' ', before[x], x, y})
+		}
+		if x == previousX {
+			y--
+			lines = append(lines, diffLine{'+', after[y], x, y})
+		} else {
+			x--
+			lines = append(lines, diffLine{'-', before[x], x, y})
+		}
+	}
+	for x > 0 && y > 0 {
+		x, y = x-1, y-1
+		lines = append(lines, diffLine{' ', before[x], x, y})
+	}
+	for left, right := 0, len(lines)-1; left < right; left, right = left+1, right-1 {
+		lines[left], lines[right] = lines[right], lines[left]
+	}
+	return lines
+}
+
+// splitDiffLines splits the :contents into lines for diffing, keeping the
+// line endings
+func splitDiffLines(contents string) []string {
+	lines := strings.SplitAfter(contents, "\n")
+	if len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/zephinzer/semver/semver"
+)
+
+type ReleaseTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestRelease(t *testing.T) {
+	suite.Run(t, new(ReleaseTestSuite))
+}
+
+// write creates the file at :path with the :contents
+func (s *ReleaseTestSuite) write(path string, contents string) {
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		panic(err)
+	}
+}
+
+func (s *ReleaseTestSuite) read(path string) string {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+	return string(contents)
+}
+
+func (s *ReleaseTestSuite) Test_parseBumpFile() {
+	file, err := parseBumpFile("web/package.json")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "web/package.json", file.path)
+	file, err = parseBumpFile("Dockerfile")
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), file.locators, 1)
+	file, err = parseBumpFile("main.go=const Version = \"([^\"]+)\"")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "main.go", file.path)
+	file, err = parseBumpFile("values.yaml:image.tag")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "values.yaml", file.path)
+}
+
+func (s *ReleaseTestSuite) Test_parseBumpFile_invalid() {
+	_, err := parseBumpFile("README.md")
+	assert.Contains(s.T(), err.Error(), "invalid bump file 'README.md'")
+	_, err = parseBumpFile("Dockerfile:version")
+	assert.Contains(s.T(), err.Error(), "key paths are only supported for JSON, YAML and TOML files")
+	_, err = parseBumpFile("main.go=(")
+	assert.Contains(s.T(), err.Error(), "invalid pattern in bump file 'main.go=('")
+}
+
+func (s *ReleaseTestSuite) Test_replaceVersions() {
+	version := semver.MustParse("v1.3.0", "v")
+	contents := "a=v1.2.3 b=1.2.3"
+	assert.Equal(s.T(), "a=v1.3.0 b=1.3.0", replaceVersions(contents, [][]int{{2, 8}, {11, 16}}, version))
+}
+
+func (s *ReleaseTestSuite) Test_release() {
+	s.commit("initial commit")
+	s.git("tag", "1.2.3")
+	s.write("package.json", "{\"version\": \"1.2.3\"}\n")
+	s.write("Dockerfile", "FROM scratch\nLABEL version=\"1.2.3\"\n")
+	s.git("add", ".")
+	s.commit("feat: a new feature")
+	options := releaseOptions{files: []string{"package.json", "Dockerfile"}}
+	version := semver.MustParse("1.3.0")
+	assert.Nil(s.T(), options.release(&GitLoader{}, version, versionChange{"minor", "1.2.3", "1.3.0"}, "CHANGELOG.md"))
+	assert.Equal(s.T(), "chore(release): 1.3.0", s.git("log", "-1", "--format=%s"))
+	assert.Equal(s.T(), "1.3.0", s.git("tag", "--points-at", "HEAD"))
+	assert.Equal(s.T(), "{\"version\": \"1.3.0\"}\n", s.git("show", "1.3.0:package.json")+"\n")
+	assert.Contains(s.T(), s.git("show", "1.3.0:Dockerfile"), "LABEL version=\"1.3.0\"")
+	assert.Contains(s.T(), s.git("show", "1.3.0:CHANGELOG.md"), "## [1.3.0] - ")
+	assert.Contains(s.T(), s.git("show", "1.3.0:CHANGELOG.md"), "### Added\n- a new feature")
+	assert.Empty(s.T(), s.git("status", "--porcelain"))
+}
+
+func (s *ReleaseTestSuite) Test_release_withoutFiles() {
+	s.commit("initial commit")
+	assert.Nil(s.T(), releaseOptions{}.release(&GitLoader{}, semver.MustParse("0.1.0"), versionChange{}, ""))
+	assert.Equal(s.T(), "initial commit", s.git("log", "-1", "--format=%s"))
+	assert.Equal(s.T(), "0.1.0", s.git("tag", "--points-at", "HEAD"))
+}
+
+func (s *ReleaseTestSuite) Test_release_fileSource() {
+	s.write("package.json", "{\"version\": \"1.0.0\"}\n")
+	s.write("Chart.yaml", "version: 1.0.0\n")
+	s.git("add", ".")
+	s.commit("initial commit")
+	source, err := newVersionSource("npm", sourceOptions{})
+	assert.Nil(s.T(), err)
+	options := releaseOptions{files: []string{"Chart.yaml"}}
+	assert.Nil(s.T(), options.release(source, semver.MustParse("1.0.1"), versionChange{"patch", "1.0.0", "1.0.1"}, ""))
+	assert.Equal(s.T(), "chore(release): 1.0.1", s.git("log", "-1", "--format=%s"))
+	assert.Equal(s.T(), "Chart.yaml\npackage.json", s.git("show", "--format=", "--name-only", "HEAD"))
+	assert.Equal(s.T(), "{\"version\": \"1.0.1\"}\n", s.git("show", "HEAD:package.json")+"\n")
+	assert.Empty(s.T(), s.git("status", "--porcelain"))
+	assert.Empty(s.T(), s.git("tag", "--list"))
+}
+
+func (s *ReleaseTestSuite) Test_release_fileSourceWithoutFiles() {
+	s.write("package.json", "{\"version\": \"1.0.0\"}\n")
+	s.git("add", ".")
+	s.commit("initial commit")
+	source, err := newVersionSource("npm", sourceOptions{})
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), releaseOptions{}.release(source, semver.MustParse("1.0.1"), versionChange{}, ""))
+	assert.Equal(s.T(), "initial commit", s.git("log", "-1", "--format=%s"))
+	assert.Equal(s.T(), "{\"version\": \"1.0.1\"}\n", s.read("package.json"))
+}
+
+func (s *ReleaseTestSuite) Test_release_dryRun() {
+	s.commit("initial commit")
+	s.write("package.json", "{\"version\": \"1.2.3\"}\n")
+	options := releaseOptions{files: []string{"package.json"}, dryRun: true}
+	assert.Nil(s.T(), options.release(&GitLoader{}, semver.MustParse("1.3.0"), versionChange{}, ""))
+	assert.Equal(s.T(), "{\"version\": \"1.2.3\"}\n", s.read("package.json"))
+	assert.Empty(s.T(), s.git("tag", "--list"))
+	assert.Equal(s.T(), "initial commit", s.git("log", "-1", "--format=%s"))
+}
+
+func (s *ReleaseTestSuite) Test_release_missingVersion() {
+	s.commit("initial commit")
+	s.write("package.json", "{}\n")
+	options := releaseOptions{files: []string{"package.json"}}
+	assert.EqualError(s.T(), options.release(&GitLoader{}, semver.MustParse("1.3.0"), versionChange{}, ""), "no version found in package.json")
+	assert.Empty(s.T(), s.git("tag", "--list"))
+}
+
+func (s *ReleaseTestSuite) Test_renderDiff() {
+	before := "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\neleven\ntwelve\n"
+	after := "one\n2\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\neleven\n12\n"
+	assert.Equal(s.T(), `--- a/numbers
++++ b/numbers
+@@ -1,5 +1,5 @@
+ one
+-two
++2
+ three
+ four
+ five
+@@ -9,4 +9,4 @@
+ nine
+ ten
+ eleven
+-twelve
++12
+`, renderDiff(fileChange{"numbers", before, after}))
+	assert.Equal(s.T(), "--- a/new\n+++ b/new\n@@ -1,0 +1,1 @@\n+line\n", renderDiff(fileChange{"new", "", "line\n"}))
+}
+
+func (s *ReleaseTestSuite) Test_renderDiff_largeFile() {
+	var before strings.Builder
+	for index := 0; index < 50000; index++ {
+		before.WriteString(fmt.Sprintf("line %d\n", index))
+	}
+	after := "# Changelog\n" + strings.Replace(before.String(), "line 25000\n", "line 25000 changed\n", 1)
+	assert.Equal(s.T(), `--- a/CHANGELOG.md
++++ b/CHANGELOG.md
+@@ -1,3 +1,4 @@
++# Changelog
+ line 0
+ line 1
+ line 2
+@@ -24998,7 +24999,7 @@
+ line 24997
+ line 24998
+ line 24999
+-line 25000
++line 25000 changed
+ line 25001
+ line 25002
+ line 25003
+`, renderDiff(fileChange{"CHANGELOG.md", before.String(), after}))
+}
+
+func (s *ReleaseTestSuite) Test_checkBranch() {
+	s.commit("initial commit")
+	s.git("checkout", "-b", "release/1.x")
+	policies := []branchPolicy{{Name: "main"}, {Name: "release/*", Bumps: []string{"patch"}}}
+	assert.Nil(s.T(), releaseOptions{}.checkBranch("major"))
+	assert.Nil(s.T(), releaseOptions{branches: policies}.checkBranch("patch"))
+	assert.EqualError(s.T(), releaseOptions{branches: policies}.checkBranch("minor"), "minor releases are not allowed on branch 'release/1.x', expected one of patch")
+	assert.Nil(s.T(), releaseOptions{branch: "main", branches: policies}.checkBranch("major"))
+	assert.EqualError(s.T(), releaseOptions{branch: "feature", branches: policies}.checkBranch("patch"), "releases are not allowed on branch 'feature'")
+	s.git("checkout", "--detach")
+	assert.Contains(s.T(), releaseOptions{branches: policies}.checkBranch("patch").Error(), "specify it using --branch")
+}
+
+func (s *ReleaseTestSuite) Test_release_branchPolicy() {
+	s.commit("initial commit")
+	options := releaseOptions{branch: "feature", branches: []branchPolicy{{Name: "main"}}}
+	assert.NotNil(s.T(), options.release(&GitLoader{}, semver.MustParse("0.1.0"), versionChange{"minor", "0.0.0", "0.1.0"}, ""))
+	assert.Empty(s.T(), s.git("tag", "--list"))
+}
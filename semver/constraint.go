@@ -0,0 +1,327 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ConstraintError is returned when a string cannot be parsed as a constraint
+type ConstraintError struct {
+	Constraint string
+	Reason     string
+}
+
+// Error implements the error interface
+func (constraintError *ConstraintError) Error() string {
+	return fmt.Sprintf("invalid constraint '%s': %s", constraintError.Constraint, constraintError.Reason)
+}
+
+// Constraint is a union of version ranges, each of which is an intersection
+// of comparisons such as `>=1.4.0 <2.0.0`
+type Constraint struct {
+	constraint string
+	ranges     [][]comparison
+}
+
+// comparison compares versions against a version using an operator, one of
+// "=", "<", "<=", ">" or ">="
+type comparison struct {
+	operator string
+	version  *Semver
+}
+
+// constraintOperatorSpacing matches the whitespace between an operator and
+// its version, which is allowed but not significant
+var constraintOperatorSpacing = regexp.MustCompile(`(>=|<=|>|<|=|~|\^)\s+`)
+
+// ParseConstraint converts the string :from into a Constraint, returning a
+// *ConstraintError describing why :from is not a valid constraint otherwise.
+// Versions in the constraint may include the :prefix. The syntax follows
+// npm, with version ranges separated by `||` and the comparisons of a range
+// separated by whitespace or commas:
+//   - `1.2.3`, `=1.2.3`: exactly the version
+//   - `>1.2.3`, `>=1.2.3`, `<1.2.3`, `<=1.2.3`: a comparison with the version
+//   - `1.2.x`, `1.x`, `1.2`, `*`: any version matching the wildcards or the
+//     versions specified
+//   - `~1.2.3`: patch versions from the version on (>=1.2.3 <1.3.0)
+//   - `^1.2.3`: versions from the version on up to the next version changing
+//     the leftmost non-zero section (>=1.2.3 <2.0.0, ^0.2.3 is <0.3.0)
+//   - `1.2.3 - 2.3.4`: versions from the first to the second (>=1.2.3 <=2.3.4)
+//
+// Prereleases only satisfy a range which has a comparison with a prerelease
+// of the same major, minor and patch version
+func ParseConstraint(from string, prefix ...string) (*Constraint, error) {
+	versionPrefix := ""
+	if len(prefix) > 0 {
+		versionPrefix = prefix[0]
+	}
+	constraint := &Constraint{constraint: from}
+	for _, versionRange := range strings.Split(from, "||") {
+		versionRange = constraintOperatorSpacing.ReplaceAllString(strings.Replace(versionRange, ",", " ", -1), "$1")
+		comparisons, err := parseRange(strings.Fields(versionRange), versionPrefix)
+		if err != nil {
+			return nil, &ConstraintError{from, err.Error()}
+		}
+		constraint.ranges = append(constraint.ranges, comparisons)
+	}
+	return constraint, nil
+}
+
+// MustParseConstraint is like ParseConstraint but panics if :from cannot be
+// parsed
+func MustParseConstraint(from string, prefix ...string) *Constraint {
+	constraint, err := ParseConstraint(from, prefix...)
+	if err != nil {
+		panic(err)
+	}
+	return constraint
+}
+
+// Check returns true if the :version satisfies any of the ranges of the
+// constraint
+func (constraint *Constraint) Check(version ISemver) bool {
+	for _, comparisons := range constraint.ranges {
+		if satisfiesRange(version, comparisons) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the constraint as it was parsed
+func (constraint *Constraint) String() string {
+	return constraint.constraint
+}
+
+// MaxSatisfying returns the version with the highest precedence among the
+// :versions which satisfies the :constraint, or nil if none of them do
+func MaxSatisfying(versions []ISemver, constraint *Constraint) ISemver {
+	var highest ISemver
+	for _, version := range versions {
+		if constraint.Check(version) && (highest == nil || Compare(version, highest) > 0) {
+			highest = version
+		}
+	}
+	return highest
+}
+
+// satisfiesRange returns true if the :version satisfies all :comparisons.
+// prereleases only satisfy them if one of the comparisons is with a
+// prerelease of the same major, minor and patch version
+func satisfiesRange(version ISemver, comparisons []comparison) bool {
+	for _, comparison := range comparisons {
+		if !comparison.check(version) {
+			return false
+		}
+	}
+	if len(version.GetLabel()) == 0 {
+		return true
+	}
+	for _, comparison := range comparisons {
+		if len(comparison.version.label) > 0 &&
+			comparison.version.major == version.GetMajorInt() &&
+			comparison.version.minor == version.GetMinorInt() &&
+			comparison.version.patch == version.GetPatchInt() {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns true if the :version satisfies the comparison
+func (comparison comparison) check(version ISemver) bool {
+	result := Compare(version, comparison.version)
+	switch comparison.operator {
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	}
+	return result == 0
+}
+
+// parseRange converts the :terms of a version range into the comparisons
+// they stand for, an empty range matching all versions
+func parseRange(terms []string, prefix string) ([]comparison, error) {
+	if len(terms) == 3 && terms[1] == "-" {
+		return parseHyphenRange(terms[0], terms[2], prefix)
+	}
+	comparisons := []comparison{}
+	for _, term := range terms {
+		if term == "-" {
+			return nil, fmt.Errorf("expected a hyphen range of the form 'A - B'")
+		}
+		termComparisons, err := parseTerm(term, prefix)
+		if err != nil {
+			return nil, err
+		}
+		comparisons = append(comparisons, termComparisons...)
+	}
+	if len(comparisons) == 0 {
+		comparisons = append(comparisons, comparison{">=", &Semver{}})
+	}
+	return comparisons, nil
+}
+
+// parseHyphenRange converts the hyphen range from :lower to :upper into
+// comparisons. a partial :upper includes all versions it matches
+func parseHyphenRange(lower string, upper string, prefix string) ([]comparison, error) {
+	from, err := parsePartialVersion(lower, prefix)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parsePartialVersion(upper, prefix)
+	if err != nil {
+		return nil, err
+	}
+	comparisons := []comparison{{">=", from.lowest()}}
+	switch to.parts {
+	case 0:
+	case 3:
+		comparisons = append(comparisons, comparison{"<=", to.lowest()})
+	default:
+		comparisons = append(comparisons, comparison{"<", to.next(to.parts)})
+	}
+	return comparisons, nil
+}
+
+// parseTerm converts a single comparison :term, such as `>=1.2` or `^1.2.3`,
+// into the comparisons it stands for
+func parseTerm(term string, prefix string) ([]comparison, error) {
+	operator := ""
+	for _, candidate := range []string{">=", "<=", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(term, candidate) {
+			operator = candidate
+			break
+		}
+	}
+	version, err := parsePartialVersion(strings.TrimPrefix(term, operator), prefix)
+	if err != nil {
+		return nil, err
+	}
+	switch operator {
+	case ">":
+		if version.parts < 3 {
+			if version.parts == 0 {
+				return []comparison{{"<", &Semver{}}}, nil
+			}
+			next := version.next(version.parts)
+			next.label = ""
+			return []comparison{{">=", next}}, nil
+		}
+		return []comparison{{">", version.lowest()}}, nil
+	case ">=":
+		return []comparison{{">=", version.lowest()}}, nil
+	case "<":
+		if version.parts < 3 {
+			return []comparison{{"<", &Semver{version.major, version.minor, version.patch, "0", "", ""}}}, nil
+		}
+		return []comparison{{"<", version.lowest()}}, nil
+	case "<=":
+		if version.parts == 0 {
+			return []comparison{{">=", &Semver{}}}, nil
+		} else if version.parts < 3 {
+			return []comparison{{"<", version.next(version.parts)}}, nil
+		}
+		return []comparison{{"<=", version.lowest()}}, nil
+	case "~":
+		if version.parts < 2 {
+			return version.wildcard(version.parts), nil
+		}
+		return []comparison{{">=", version.lowest()}, {"<", version.next(2)}}, nil
+	case "^":
+		significant := 1
+		if version.major == 0 && version.parts >= 2 {
+			significant = 2
+			if version.minor == 0 && version.parts == 3 {
+				significant = 3
+			}
+		}
+		if version.parts < significant {
+			return version.wildcard(version.parts), nil
+		}
+		return []comparison{{">=", version.lowest()}, {"<", version.next(significant)}}, nil
+	}
+	if version.parts < 3 {
+		return version.wildcard(version.parts), nil
+	}
+	return []comparison{{"=", version.lowest()}}, nil
+}
+
+// partialVersion is a version in a constraint, which may leave out or use
+// wildcards for its minor and patch versions
+type partialVersion struct {
+	major int
+	minor int
+	patch int
+	label string
+	// parts is the number of sections which were specified
+	parts int
+}
+
+// parsePartialVersion converts the string :from, which may include the
+// :prefix, into a partialVersion
+func parsePartialVersion(from string, prefix string) (partialVersion, error) {
+	value := strings.TrimPrefix(from, prefix)
+	if len(value) == 0 {
+		return partialVersion{}, nil
+	}
+	if version, err := Parse(value); err == nil {
+		return partialVersion{version.major, version.minor, version.patch, version.label, 3}, nil
+	}
+	sections := strings.Split(value, ".")
+	if len(sections) > 3 {
+		return partialVersion{}, fmt.Errorf("invalid version '%s'", from)
+	}
+	var version partialVersion
+	numbers := []*int{&version.major, &version.minor, &version.patch}
+	for index, section := range sections {
+		if section == "x" || section == "X" || section == "*" {
+			break
+		}
+		number, err := parseNumericIdentifier(section)
+		if err != nil {
+			return partialVersion{}, fmt.Errorf("invalid version '%s'", from)
+		}
+		*numbers[index] = number
+		version.parts++
+	}
+	for _, section := range sections[version.parts:] {
+		if section != "x" && section != "X" && section != "*" {
+			return partialVersion{}, fmt.Errorf("invalid version '%s', sections cannot follow a wildcard", from)
+		}
+	}
+	return version, nil
+}
+
+// lowest returns the lowest version matching the partial version
+func (version partialVersion) lowest() *Semver {
+	return &Semver{version.major, version.minor, version.patch, version.label, "", ""}
+}
+
+// next returns the lowest prerelease of the version after the partial
+// version with its :significant leftmost sections bumped, eg. 1.3.0-0 for
+// 1.2.x with 2 significant sections
+func (version partialVersion) next(significant int) *Semver {
+	switch significant {
+	case 1:
+		return &Semver{version.major + 1, 0, 0, "0", "", ""}
+	case 2:
+		return &Semver{version.major, version.minor + 1, 0, "0", "", ""}
+	}
+	return &Semver{version.major, version.minor, version.patch + 1, "0", "", ""}
+}
+
+// wildcard returns the comparisons matching all versions with the first
+// :parts sections of the partial version
+func (version partialVersion) wildcard(parts int) []comparison {
+	if parts == 0 {
+		return []comparison{{">=", &Semver{}}}
+	}
+	return []comparison{{">=", version.lowest()}, {"<", version.next(parts)}}
+}
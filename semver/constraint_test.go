@@ -0,0 +1,85 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConstraintTestSuite struct {
+	suite.Suite
+}
+
+func TestConstraint(t *testing.T) {
+	suite.Run(t, new(ConstraintTestSuite))
+}
+
+func (s *ConstraintTestSuite) TestCheck() {
+	for constraint, versions := range map[string]map[string]bool{
+		">=1.4.0 <2.0.0":                  {"1.4.0": true, "1.9.9": true, "1.3.9": false, "2.0.0": false, "1.5.0-rc.1": false},
+		">= 1.4.0, < 2.0.0":               {"1.4.0": true, "2.0.0": false},
+		"1.2.3":                           {"1.2.3": true, "1.2.3+build.1": true, "1.2.4": false},
+		"=1.2.3":                          {"1.2.3": true, "1.2.4": false},
+		">1.2.3":                          {"1.2.4": true, "1.2.3": false},
+		"<=1.2.3":                         {"1.2.3": true, "1.2.4": false},
+		">1.2":                            {"1.3.0": true, "1.2.9": false, "1.3.0-alpha": false},
+		"<1.2":                            {"1.1.9": true, "1.2.0": false, "1.2.0-alpha": false},
+		"<=1.2":                           {"1.2.9": true, "1.3.0": false},
+		"^1.2.3":                          {"1.2.3": true, "1.9.0": true, "2.0.0": false, "1.2.2": false, "2.0.0-rc.1": false},
+		"^0.2.3":                          {"0.2.3": true, "0.2.9": true, "0.3.0": false},
+		"^0.0.3":                          {"0.0.3": true, "0.0.4": false},
+		"^1.2":                            {"1.2.0": true, "1.9.0": true, "2.0.0": false},
+		"^0.0":                            {"0.0.9": true, "0.1.0": false},
+		"^1.x":                            {"1.0.0": true, "2.0.0": false},
+		"~1.2.3":                          {"1.2.3": true, "1.2.9": true, "1.3.0": false},
+		"~1.2":                            {"1.2.0": true, "1.3.0": false},
+		"~1":                              {"1.9.0": true, "2.0.0": false},
+		"1.x":                             {"1.0.0": true, "1.9.9": true, "2.0.0": false, "0.9.0": false},
+		"1.2.*":                           {"1.2.0": true, "1.3.0": false},
+		"1.2":                             {"1.2.7": true, "1.3.0": false},
+		"*":                               {"0.0.0": true, "9.9.9": true, "1.0.0-rc.1": false},
+		"":                                {"1.0.0": true},
+		"1.2.3 - 2.3.4":                   {"1.2.3": true, "2.3.4": true, "2.3.5": false, "1.2.2": false},
+		"1.2 - 2.3":                       {"1.2.0": true, "2.3.9": true, "2.4.0": false},
+		"1.x || >=2.5.0 || 5.0.0 - 7.2.3": {"1.2.3": true, "2.1.0": false, "2.5.0": true, "6.0.0": true},
+		">=1.2.3-beta.2 <1.3.0":           {"1.2.3-beta.2": true, "1.2.3-beta.10": true, "1.2.3-alpha": false, "1.2.4-beta": false, "1.2.9": true},
+		"~1.2.3-beta.2":                   {"1.2.3-beta.4": true, "1.2.4-beta.2": false, "1.2.4": true},
+	} {
+		parsed, err := ParseConstraint(constraint)
+		assert.Nil(s.T(), err, constraint)
+		for version, expected := range versions {
+			assert.Equal(s.T(), expected, parsed.Check(MustParse(version)), "%s satisfies %s", version, constraint)
+		}
+	}
+}
+
+func (s *ConstraintTestSuite) TestCheck_prefix() {
+	constraint := MustParseConstraint("^v1.2.0 || 3.x", "v")
+	assert.True(s.T(), constraint.Check(MustParse("v1.4.0", "v")))
+	assert.True(s.T(), constraint.Check(MustParse("v3.1.0", "v")))
+	assert.False(s.T(), constraint.Check(MustParse("v2.0.0", "v")))
+	assert.Equal(s.T(), "^v1.2.0 || 3.x", constraint.String())
+}
+
+func (s *ConstraintTestSuite) TestParseConstraint_invalid() {
+	for constraint, reason := range map[string]string{
+		">=1.2.3.4":   "invalid version '1.2.3.4'",
+		"1.x.3":       "invalid version '1.x.3', sections cannot follow a wildcard",
+		"^a.b":        "invalid version 'a.b'",
+		"1.2.3 - ":    "expected a hyphen range of the form 'A - B'",
+		"1.0 - 2 - 3": "expected a hyphen range of the form 'A - B'",
+		">=01.2.3":    "invalid version '01.2.3'",
+	} {
+		_, err := ParseConstraint(constraint)
+		assert.EqualError(s.T(), err, "invalid constraint '"+constraint+"': "+reason, constraint)
+	}
+	assert.Panics(s.T(), func() { MustParseConstraint(">=1.2.3.4") })
+}
+
+func (s *ConstraintTestSuite) TestMaxSatisfying() {
+	versions := []ISemver{MustParse("1.2.0"), MustParse("1.4.0"), MustParse("1.10.0"), MustParse("2.0.0"), MustParse("1.11.0-rc.0")}
+	assert.Equal(s.T(), "1.10.0", MaxSatisfying(versions, MustParseConstraint("^1.2.0")).String())
+	assert.Equal(s.T(), "1.4.0", MaxSatisfying(versions, MustParseConstraint("~1.4")).String())
+	assert.Nil(s.T(), MaxSatisfying(versions, MustParseConstraint(">=3.0.0")))
+}
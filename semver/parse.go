@@ -0,0 +1,137 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseError is returned when a string cannot be parsed as a semver version
+type ParseError struct {
+	Version string
+	Reason  string
+}
+
+// Error implements the error interface
+func (parseError *ParseError) Error() string {
+	return fmt.Sprintf("invalid semver '%s': %s", parseError.Version, parseError.Reason)
+}
+
+// IsSemverLike returns true if the provided :version is a valid semver
+// version as defined by the SemVer 2.0.0 specification, and false otherwise
+func IsSemverLike(version string, prefix ...string) bool {
+	_, err := Parse(version, prefix...)
+	return err == nil
+}
+
+// Parse converts the string :from into a Semver as specified by SemVer 2.0.0,
+// returning a *ParseError describing why :from is not a valid version
+// otherwise
+func Parse(from string, prefix ...string) (*Semver, error) {
+	versionPrefix := ""
+	if len(prefix) > 0 {
+		versionPrefix = prefix[0]
+	}
+	if !strings.HasPrefix(from, versionPrefix) {
+		return nil, &ParseError{from, fmt.Sprintf("expected prefix '%s'", versionPrefix)}
+	}
+	version := strings.TrimPrefix(from, versionPrefix)
+	build := ""
+	if index := strings.Index(version, "+"); index >= 0 {
+		build = version[index+1:]
+		version = version[:index]
+		if err := validateIdentifiers(build, "build metadata", false); err != nil {
+			return nil, &ParseError{from, err.Error()}
+		}
+	}
+	label := ""
+	if index := strings.Index(version, "-"); index >= 0 {
+		label = version[index+1:]
+		version = version[:index]
+		if err := validateIdentifiers(label, "pre-release", true); err != nil {
+			return nil, &ParseError{from, err.Error()}
+		}
+	}
+	versionNumbers := strings.Split(version, ".")
+	if len(versionNumbers) != 3 {
+		return nil, &ParseError{from, "expected a version core of the form MAJOR.MINOR.PATCH"}
+	}
+	var numbers [3]int
+	for index, name := range []string{"major", "minor", "patch"} {
+		number, err := parseNumericIdentifier(versionNumbers[index])
+		if err != nil {
+			return nil, &ParseError{from, fmt.Sprintf("%s version %s", name, err)}
+		}
+		numbers[index] = number
+	}
+	return &Semver{numbers[0], numbers[1], numbers[2], label, build, versionPrefix}, nil
+}
+
+// MustParse is like Parse but panics if :from cannot be parsed
+func MustParse(from string, prefix ...string) *Semver {
+	semver, err := Parse(from, prefix...)
+	if err != nil {
+		panic(err)
+	}
+	return semver
+}
+
+// parseNumericIdentifier converts :identifier into an integer, disallowing
+// empty values, non-digits and leading zeroes
+func parseNumericIdentifier(identifier string) (int, error) {
+	if len(identifier) == 0 {
+		return 0, fmt.Errorf("is empty")
+	} else if !IsNumericIdentifier(identifier) {
+		return 0, fmt.Errorf("'%s' is not numeric", identifier)
+	} else if len(identifier) > 1 && identifier[0] == '0' {
+		return 0, fmt.Errorf("'%s' has a leading zero", identifier)
+	}
+	number, err := strconv.Atoi(identifier)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is out of range", identifier)
+	}
+	return number, nil
+}
+
+// validateIdentifiers checks that each dot-separated identifier in :section
+// is non-empty and contains only [0-9A-Za-z-]. When :strictNumbers is true,
+// numeric identifiers must not have leading zeroes
+func validateIdentifiers(section string, name string, strictNumbers bool) error {
+	for _, identifier := range strings.Split(section, ".") {
+		if len(identifier) == 0 {
+			return fmt.Errorf("%s contains an empty identifier", name)
+		}
+		for _, character := range identifier {
+			if !isIdentifierCharacter(character) {
+				return fmt.Errorf("%s identifier '%s' contains invalid character '%c'", name, identifier, character)
+			}
+		}
+		if strictNumbers && IsNumericIdentifier(identifier) && len(identifier) > 1 && identifier[0] == '0' {
+			return fmt.Errorf("%s identifier '%s' has a leading zero", name, identifier)
+		}
+	}
+	return nil
+}
+
+// isIdentifierCharacter returns true if :character is allowed in a
+// pre-release or build metadata identifier
+func isIdentifierCharacter(character rune) bool {
+	return (character >= '0' && character <= '9') ||
+		(character >= 'a' && character <= 'z') ||
+		(character >= 'A' && character <= 'Z') ||
+		character == '-'
+}
+
+// IsNumericIdentifier returns true if the prerelease or build :identifier
+// consists only of digits
+func IsNumericIdentifier(identifier string) bool {
+	if len(identifier) == 0 {
+		return false
+	}
+	for _, character := range identifier {
+		if character < '0' || character > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,190 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ParseTestSuite struct {
+	suite.Suite
+}
+
+func TestParse(t *testing.T) {
+	suite.Run(t, new(ParseTestSuite))
+}
+
+func (s *ParseTestSuite) TestIsSemverLike_basicChecks_isSemver() {
+	isSemvers := []string{
+		"0.0.0",
+		"1.0.0",
+		"10.0.0",
+		"0.0.0",
+		"0.1.0",
+		"0.10.0",
+		"0.0.0",
+		"0.0.1",
+		"0.0.10",
+		"0.0.0-alpha",
+		"0.0.0-alpha.1",
+		"0.0.0-beta",
+		"0.0.0-beta.1",
+		"0.0.0-rc",
+		"0.0.0-rc.42",
+	}
+	for _, isSemver := range isSemvers {
+		assert.True(s.T(), IsSemverLike(isSemver))
+	}
+}
+
+func (s *ParseTestSuite) TestIsSemverLike_basicChecks_notSemver() {
+	notSemvers := []string{
+		"00.0.0",
+		"0.00.0",
+		"0.0.00",
+		"a.0.0",
+		"1.b.0",
+		"10.0.c",
+		"a.0.0",
+		"0.1.b",
+		"0.10.c",
+		"!.0.0",
+		"0.X.1",
+		"0.*.10",
+	}
+	for _, notSemver := range notSemvers {
+		assert.False(s.T(), IsSemverLike(notSemver))
+	}
+}
+
+func (s *ParseTestSuite) TestIsSemverLike_basicChecks_isSemverWithLabel() {
+	isSemvers := []string{
+		"0.0.0-label",
+		"0.0.0-labelWithCaps",
+		"0.0.0-label.with.dots",
+		"0.0.0-label-with-dashes",
+		"0.0.0-label.with-all-possible.delims",
+		"0.0.0-rc-1",
+		"0.0.0-0.3.7",
+		"0.0.0-x.7.z.92",
+	}
+	for _, isSemver := range isSemvers {
+		assert.True(s.T(), IsSemverLike(isSemver))
+	}
+}
+
+func (s *ParseTestSuite) TestIsSemverLike_basicChecks_notSemverWithLabel() {
+	isSemvers := []string{
+		"0.0.0label",
+		"0.0.00000",
+		"0.0.0-",
+		"0.0.0-label_with_underscores",
+		"0.0.0-label..empty",
+		"0.0.0-label.",
+		"0.0.0-01",
+		"0.0.0-rc.01",
+	}
+	for _, isSemver := range isSemvers {
+		assert.False(s.T(), IsSemverLike(isSemver))
+	}
+}
+
+func (s *ParseTestSuite) TestIsSemverLike_buildMetadata() {
+	isSemvers := []string{
+		"1.0.0+20130313144700",
+		"1.0.0-alpha+001",
+		"1.0.0-beta+exp.sha.5114f85",
+		"1.0.0+21AF26D3-117B344092BD",
+		"1.2.3+sha.abc",
+		"1.2.3+build.007",
+	}
+	for _, isSemver := range isSemvers {
+		assert.True(s.T(), IsSemverLike(isSemver), isSemver)
+	}
+	notSemvers := []string{
+		"1.0.0+",
+		"1.0.0+build..1",
+		"1.0.0+build_1",
+		"1.0.0+build+1",
+	}
+	for _, notSemver := range notSemvers {
+		assert.False(s.T(), IsSemverLike(notSemver), notSemver)
+	}
+}
+
+func (s *ParseTestSuite) TestParse() {
+	testCases := map[string]*Semver{
+		"1.0.0":           New(1, 0, 0, ""),
+		"1.0.0-alpha.5":   New(1, 0, 0, "alpha.5"),
+		"1.1.0":           New(1, 1, 0, ""),
+		"1.1.1":           New(1, 1, 1, ""),
+		"1.1.10":          New(1, 1, 10, ""),
+		"1.10.10":         New(1, 10, 10, ""),
+		"10.10.10":        New(10, 10, 10, ""),
+		"10.10.10-beta.3": New(10, 10, 10, "beta.3"),
+		"10.10.10-beta":   New(10, 10, 10, "beta"),
+		"10.11.0":         New(10, 11, 0, ""),
+	}
+	for version, expected := range testCases {
+		semver, err := Parse(version)
+		assert.Nil(s.T(), err)
+		assert.Equal(s.T(), expected, semver)
+	}
+}
+
+func (s *ParseTestSuite) TestParse_withPrefix() {
+	semver, err := Parse("v1.2.3-rc.1", "v")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), New(1, 2, 3, "rc.1", "v"), semver)
+	assert.Equal(s.T(), "v1.2.3-rc.1", semver.String())
+}
+
+func (s *ParseTestSuite) TestParse_withBuildMetadata() {
+	semver, err := Parse("1.2.3-rc-1.2+sha.abc")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, semver.GetMajorInt())
+	assert.Equal(s.T(), 2, semver.GetMinorInt())
+	assert.Equal(s.T(), 3, semver.GetPatchInt())
+	assert.Equal(s.T(), "rc-1.2", semver.GetLabel())
+	assert.Equal(s.T(), "sha.abc", semver.GetBuild())
+	assert.Equal(s.T(), "1.2.3-rc-1.2+sha.abc", semver.String())
+}
+
+func (s *ParseTestSuite) TestParse_errors() {
+	testCases := map[string]string{
+		"1.2":         "invalid semver '1.2': expected a version core of the form MAJOR.MINOR.PATCH",
+		"01.2.3":      "invalid semver '01.2.3': major version '01' has a leading zero",
+		"1.x.3":       "invalid semver '1.x.3': minor version 'x' is not numeric",
+		"1.2.":        "invalid semver '1.2.': patch version is empty",
+		"1.2.3-rc..1": "invalid semver '1.2.3-rc..1': pre-release contains an empty identifier",
+		"1.2.3-rc.01": "invalid semver '1.2.3-rc.01': pre-release identifier '01' has a leading zero",
+		"1.2.3+a_b":   "invalid semver '1.2.3+a_b': build metadata identifier 'a_b' contains invalid character '_'",
+	}
+	for version, expected := range testCases {
+		_, err := Parse(version)
+		assert.IsType(s.T(), &ParseError{}, err)
+		assert.EqualError(s.T(), err, expected)
+	}
+	_, err := Parse("99999999999999999999.0.0")
+	assert.EqualError(s.T(), err, "invalid semver '99999999999999999999.0.0': major version '99999999999999999999' is out of range")
+}
+
+func (s *ParseTestSuite) TestParse_invalid() {
+	_, err := Parse("not.a.version")
+	assert.NotNil(s.T(), err)
+	_, err = Parse("1.2.3", "v")
+	assert.NotNil(s.T(), err)
+}
+
+func (s *ParseTestSuite) TestMustParse() {
+	assert.Equal(s.T(), New(1, 2, 3, ""), MustParse("1.2.3"))
+	assert.Panics(s.T(), func() { MustParse("1.2") })
+}
+
+func (s *ParseTestSuite) TestIsNumericIdentifier() {
+	assert.True(s.T(), IsNumericIdentifier("42"))
+	assert.True(s.T(), IsNumericIdentifier("007"))
+	assert.False(s.T(), IsNumericIdentifier("rc1"))
+	assert.False(s.T(), IsNumericIdentifier(""))
+}
@@ -1,4 +1,6 @@
-package main
+// Package semver provides a model for semantic versions along with the
+// utilities to parse, compare and sort them
+package semver
 
 import (
 	"fmt"
@@ -13,6 +15,12 @@ type ISemver interface {
 	BumpMajor()
 	BumpMinor()
 	BumpPatch()
+	BumpPremajor(string)
+	BumpPreminor(string)
+	BumpPrepatch(string)
+	BumpPrerelease()
+	Develop(int)
+	GetBuild() string
 	GetLabel() string
 	GetLabelInt() int
 	GetLabelString() string
@@ -22,11 +30,16 @@ type ISemver interface {
 	GetPatchInt() int
 	GetPrefix() string
 	Load(SemverLoader) error
+	Promote(string)
+	Release()
+	SetBuild(string) error
 	String() string
 }
 
-// SemverLoader should load a Semver value from an arbitrary source
-type SemverLoader func() (int, int, int, string, string, error)
+// SemverLoader should load a Semver value from an arbitrary source, returning
+// in order the major, minor and patch versions, the label, the build metadata
+// and the prefix
+type SemverLoader func() (int, int, int, string, string, string, error)
 
 // Semver holds the data structure for a semantic versioning model
 type Semver struct {
@@ -34,6 +47,7 @@ type Semver struct {
 	minor  int
 	patch  int
 	label  string
+	build  string
 	prefix string
 }
 
@@ -43,6 +57,7 @@ func (semver *Semver) BumpMajor() {
 	semver.minor = 0
 	semver.patch = 0
 	semver.label = ""
+	semver.build = ""
 }
 
 // BumpMinor adds 1 to the minor version and resets the patch version to 0
@@ -50,18 +65,103 @@ func (semver *Semver) BumpMinor() {
 	semver.minor++
 	semver.patch = 0
 	semver.label = ""
+	semver.build = ""
 }
 
 // BumpPatch adds 1 to the patch version
 func (semver *Semver) BumpPatch() {
 	semver.patch++
 	semver.label = ""
+	semver.build = ""
+}
+
+// BumpPremajor bumps the major version and starts a prerelease of it on the
+// :channel, eg. 1.4.2 becomes 2.0.0-rc.0 for the channel 'rc'
+func (semver *Semver) BumpPremajor(channel string) {
+	semver.BumpMajor()
+	semver.label = channel + ".0"
+}
+
+// BumpPreminor bumps the minor version and starts a prerelease of it on the
+// :channel, eg. 1.4.2 becomes 1.5.0-rc.0 for the channel 'rc'
+func (semver *Semver) BumpPreminor(channel string) {
+	semver.BumpMinor()
+	semver.label = channel + ".0"
+}
+
+// BumpPrepatch bumps the patch version and starts a prerelease of it on the
+// :channel, eg. 1.4.2 becomes 1.4.3-rc.0 for the channel 'rc'
+func (semver *Semver) BumpPrepatch(channel string) {
+	semver.BumpPatch()
+	semver.label = channel + ".0"
+}
+
+// BumpPrerelease adds 1 to the trailing number of the label, appending a
+// `.0` if there is none. versions without a label have their patch version
+// bumped and the label set to `0`
+func (semver *Semver) BumpPrerelease() {
+	semver.build = ""
+	if len(semver.label) == 0 {
+		semver.patch++
+		semver.label = "0"
+		return
+	}
+	identifiers := strings.Split(semver.label, ".")
+	last := identifiers[len(identifiers)-1]
+	if number, err := strconv.Atoi(last); err == nil && IsNumericIdentifier(last) {
+		identifiers[len(identifiers)-1] = strconv.Itoa(number + 1)
+	} else {
+		identifiers = append(identifiers, "0")
+	}
+	semver.label = strings.Join(identifiers, ".")
+}
+
+// Promote moves the prerelease to the :channel, restarting its number, eg.
+// 2.0.0-beta.3 becomes 2.0.0-rc.0 for the channel 'rc'
+func (semver *Semver) Promote(channel string) {
+	semver.label = channel + ".0"
+	semver.build = ""
+}
+
+// Release removes the label and build metadata, eg. 2.0.0-rc.4 becomes 2.0.0
+func (semver *Semver) Release() {
+	semver.label = ""
+	semver.build = ""
+}
+
+// Develop makes the version the development version :commits commits after
+// it, which sorts after it and before the next release. versions without a
+// label have their patch version bumped and the label set to `dev.<commits>`,
+// eg. 1.4.0 becomes 1.4.1-dev.7, while prereleases have `.dev.<commits>`
+// appended to their label, eg. 2.0.0-rc.1 becomes 2.0.0-rc.1.dev.7
+func (semver *Semver) Develop(commits int) {
+	semver.build = ""
+	if len(semver.label) == 0 {
+		semver.patch++
+		semver.label = fmt.Sprintf("dev.%d", commits)
+		return
+	}
+	semver.label = fmt.Sprintf("%s.dev.%d", semver.label, commits)
+}
+
+// SetBuild sets the build metadata to the :build, which is made up of
+// dot-separated identifiers of [0-9A-Za-z-]. an empty :build removes the
+// build metadata. build metadata is ignored when determining precedence
+func (semver *Semver) SetBuild(build string) error {
+	if len(build) > 0 {
+		if err := validateIdentifiers(build, "build metadata", false); err != nil {
+			return fmt.Errorf("invalid build metadata '%s': %s", build, err)
+		}
+	}
+	semver.build = build
+	return nil
 }
 
 // BumpLabel checks if the current label is present, if it is, it bumps the
 // last number set by one, otherwise, it sets the label and appends a `.0` to
 // the label
 func (semver *Semver) BumpLabel(label string) {
+	semver.build = ""
 	if strings.Index(semver.label, label) == 0 {
 		existingLabel := strings.Split(semver.label, ".")
 		if len(existingLabel) == 1 { // label
@@ -101,6 +201,11 @@ func (semver *Semver) GetPatchInt() int {
 	return semver.patch
 }
 
+// GetBuild retrieves the build metadata as-is
+func (semver *Semver) GetBuild() string {
+	return semver.build
+}
+
 // GetLabel retrieves the entire label as-is
 func (semver *Semver) GetLabel() string {
 	return semver.label
@@ -108,7 +213,7 @@ func (semver *Semver) GetLabel() string {
 
 // GetLabelInt retrieves the label version number. If there is no version number,
 // it is taken that the label is the final version of the label series and is
-// assigned a maximum integer value for sorting purposes
+// assigned a maximum integer value
 func (semver *Semver) GetLabelInt() int {
 	semverSections := strings.Split(semver.label, ".")
 	if len(semverSections) < 2 {
@@ -160,7 +265,7 @@ func (semver *Semver) GetPrefix() string {
 // Load loads the Semver struct with values from a loader function implementing
 // the SemverLoader type
 func (semver *Semver) Load(from SemverLoader) error {
-	major, minor, patch, label, prefix, err := from()
+	major, minor, patch, label, build, prefix, err := from()
 	if err != nil {
 		return err
 	}
@@ -168,6 +273,7 @@ func (semver *Semver) Load(from SemverLoader) error {
 	semver.minor = minor
 	semver.patch = patch
 	semver.label = label
+	semver.build = build
 	semver.prefix = prefix
 	return nil
 }
@@ -178,5 +284,8 @@ func (semver *Semver) String() string {
 	if len(semver.label) > 0 {
 		version = fmt.Sprintf("%s-%s", version, semver.label)
 	}
+	if len(semver.build) > 0 {
+		version = fmt.Sprintf("%s+%s", version, semver.build)
+	}
 	return version
 }
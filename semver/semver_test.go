@@ -1,4 +1,4 @@
-package main
+package semver
 
 import (
 	"testing"
@@ -17,7 +17,7 @@ func TestSemver(t *testing.T) {
 }
 
 func (s *SemverTestSuite) SetupTest() {
-	s.semver = &Semver{1, 2, 3, "label", ""}
+	s.semver = &Semver{1, 2, 3, "label", "", ""}
 }
 
 func (s *SemverTestSuite) TestBumpMajor() {
@@ -69,6 +69,80 @@ func (s *SemverTestSuite) TestBumpLabel_withNoLabel() {
 	assert.Equal(s.T(), "nonlabel.0", s.semver.GetLabel())
 }
 
+func (s *SemverTestSuite) TestBumpPremajor() {
+	s.semver = MustParse("1.4.2+build.1")
+	s.semver.BumpPremajor("rc")
+	assert.Equal(s.T(), "2.0.0-rc.0", s.semver.String())
+}
+
+func (s *SemverTestSuite) TestBumpPreminor() {
+	s.semver = MustParse("1.4.2-beta.1")
+	s.semver.BumpPreminor("alpha")
+	assert.Equal(s.T(), "1.5.0-alpha.0", s.semver.String())
+}
+
+func (s *SemverTestSuite) TestBumpPrepatch() {
+	s.semver = MustParse("v1.4.2", "v")
+	s.semver.BumpPrepatch("beta")
+	assert.Equal(s.T(), "v1.4.3-beta.0", s.semver.String())
+}
+
+func (s *SemverTestSuite) TestBumpPrerelease() {
+	for from, to := range map[string]string{
+		"2.0.0-rc.4+build.1": "2.0.0-rc.5",
+		"2.0.0-rc":           "2.0.0-rc.0",
+		"2.0.0-rc.a":         "2.0.0-rc.a.0",
+		"2.0.0-rc.1.9":       "2.0.0-rc.1.10",
+		"2.0.0":              "2.0.1-0",
+	} {
+		s.semver = MustParse(from)
+		s.semver.BumpPrerelease()
+		assert.Equal(s.T(), to, s.semver.String(), from)
+	}
+}
+
+func (s *SemverTestSuite) TestPromote() {
+	s.semver = MustParse("2.0.0-beta.3+build.1")
+	s.semver.Promote("rc")
+	assert.Equal(s.T(), "2.0.0-rc.0", s.semver.String())
+}
+
+func (s *SemverTestSuite) TestRelease() {
+	s.semver = MustParse("2.0.0-rc.4+build.1")
+	s.semver.Release()
+	assert.Equal(s.T(), "2.0.0", s.semver.String())
+}
+
+func (s *SemverTestSuite) TestDevelop() {
+	for from, to := range map[string]string{
+		"1.4.0":         "1.4.1-dev.7",
+		"1.4.0+build.1": "1.4.1-dev.7",
+		"2.0.0-rc.1":    "2.0.0-rc.1.dev.7",
+	} {
+		s.semver = MustParse(from)
+		s.semver.Develop(7)
+		assert.Equal(s.T(), to, s.semver.String(), from)
+		assert.Equal(s.T(), 1, Compare(s.semver, MustParse(from)), from)
+	}
+	assert.Equal(s.T(), -1, Compare(MustParse("1.4.1-dev.7"), MustParse("1.4.1")))
+	assert.Equal(s.T(), -1, Compare(MustParse("1.4.1-dev.7"), MustParse("1.4.1-dev.12")))
+	assert.Equal(s.T(), -1, Compare(MustParse("2.0.0-rc.1.dev.7"), MustParse("2.0.0-rc.2")))
+}
+
+func (s *SemverTestSuite) TestSetBuild() {
+	s.semver = MustParse("1.4.0-rc.2")
+	assert.Nil(s.T(), s.semver.SetBuild("build.512.sha.9f2c1e"))
+	assert.Equal(s.T(), "1.4.0-rc.2+build.512.sha.9f2c1e", s.semver.String())
+	assert.Equal(s.T(), 0, Compare(s.semver, MustParse("1.4.0-rc.2")))
+	assert.Nil(s.T(), s.semver.SetBuild("001"))
+	assert.Equal(s.T(), "1.4.0-rc.2+001", s.semver.String())
+	assert.EqualError(s.T(), s.semver.SetBuild("sha..1"), "invalid build metadata 'sha..1': build metadata contains an empty identifier")
+	assert.EqualError(s.T(), s.semver.SetBuild("sha_1"), "invalid build metadata 'sha_1': build metadata identifier 'sha_1' contains invalid character '_'")
+	assert.Equal(s.T(), "1.4.0-rc.2+001", s.semver.String())
+	assert.Nil(s.T(), s.semver.SetBuild(""))
+	assert.Equal(s.T(), "1.4.0-rc.2", s.semver.String())
+}
+
 func (s *SemverTestSuite) TestGetMajorInt() {
 	assert.Equal(s.T(), 1, s.semver.GetMajorInt())
 }
@@ -82,36 +156,36 @@ func (s *SemverTestSuite) TestGetPatchInt() {
 }
 
 func (s *SemverTestSuite) TestGetLabelInt() {
-	s.semver.Load(func() (int, int, int, string, string, error) {
-		return 1, 2, 3, "label.4", "", nil
+	s.semver.Load(func() (int, int, int, string, string, string, error) {
+		return 1, 2, 3, "label.4", "", "", nil
 	})
 	assert.Equal(s.T(), 4, s.semver.GetLabelInt())
 }
 
 func (s *SemverTestSuite) TestGetLabelString_oneDelimiter() {
-	s.semver.Load(func() (int, int, int, string, string, error) {
-		return 1, 2, 3, "label.4", "", nil
+	s.semver.Load(func() (int, int, int, string, string, string, error) {
+		return 1, 2, 3, "label.4", "", "", nil
 	})
 	assert.Equal(s.T(), "label", s.semver.GetLabelString())
 }
 
 func (s *SemverTestSuite) TestGetLabelString_noNumber() {
-	s.semver.Load(func() (int, int, int, string, string, error) {
-		return 1, 2, 3, "label", "", nil
+	s.semver.Load(func() (int, int, int, string, string, string, error) {
+		return 1, 2, 3, "label", "", "", nil
 	})
 	assert.Equal(s.T(), "label", s.semver.GetLabelString())
 }
 
 func (s *SemverTestSuite) TestGetLabelString_multiDelimiter() {
-	s.semver.Load(func() (int, int, int, string, string, error) {
-		return 1, 2, 3, "label.another.label.4", "", nil
+	s.semver.Load(func() (int, int, int, string, string, string, error) {
+		return 1, 2, 3, "label.another.label.4", "", "", nil
 	})
 	assert.Equal(s.T(), "label.another.label", s.semver.GetLabelString())
 }
 
 func (s *SemverTestSuite) TestGetLabelString_multiDelimiter_noNumber() {
-	s.semver.Load(func() (int, int, int, string, string, error) {
-		return 1, 2, 3, "label.another.label", "", nil
+	s.semver.Load(func() (int, int, int, string, string, string, error) {
+		return 1, 2, 3, "label.another.label", "", "", nil
 	})
 	assert.Equal(s.T(), "label.another.label", s.semver.GetLabelString())
 }
@@ -124,16 +198,26 @@ func (s *SemverTestSuite) TestGetLabel() {
 	assert.Equal(s.T(), "label.1", s.semver.GetLabel())
 }
 
+func (s *SemverTestSuite) TestGetBuild() {
+	s.semver.Load(func() (int, int, int, string, string, string, error) {
+		return 1, 2, 3, "label", "build.1", "", nil
+	})
+	assert.Equal(s.T(), "build.1", s.semver.GetBuild())
+	assert.Equal(s.T(), "1.2.3-label+build.1", s.semver.String())
+	s.semver.BumpPatch()
+	assert.Len(s.T(), s.semver.GetBuild(), 0)
+}
+
 func (s *SemverTestSuite) TestGetPrefix() {
-	s.semver.Load(func() (int, int, int, string, string, error) {
-		return 1, 2, 3, "label", "prefix", nil
+	s.semver.Load(func() (int, int, int, string, string, string, error) {
+		return 1, 2, 3, "label", "", "prefix", nil
 	})
 	assert.Equal(s.T(), "prefix", s.semver.GetPrefix())
 }
 
 func (s *SemverTestSuite) TestLoad() {
-	s.semver.Load(func() (int, int, int, string, string, error) {
-		return 4, 5, 6, "label.42", "", nil
+	s.semver.Load(func() (int, int, int, string, string, string, error) {
+		return 4, 5, 6, "label.42", "", "", nil
 	})
 	assert.Equal(s.T(), 4, s.semver.GetMajorInt())
 	assert.Equal(s.T(), 5, s.semver.GetMinorInt())
@@ -0,0 +1,147 @@
+package semver
+
+import (
+	"sort"
+	"strings"
+)
+
+// New creates an instance of Semver from scratch
+func New(major int, minor int, patch int, label string, prefix ...string) *Semver {
+	if len(prefix) > 0 {
+		return &Semver{major, minor, patch, label, "", prefix[0]}
+	}
+	return &Semver{major, minor, patch, label, "", ""}
+}
+
+// NewFrom creates an instance of Semver given a SemverLoader
+func NewFrom(loader SemverLoader) (*Semver, error) {
+	semver := &Semver{}
+	err := semver.Load(loader)
+	return semver, err
+}
+
+// Sort is a convenience function for sorting semvers
+func Sort(semvers []ISemver) []ISemver {
+	sort.Stable(BySemver(semvers))
+	return semvers
+}
+
+// BySemver is for sorting a slice of Semvers
+type BySemver []ISemver
+
+// Len implements the required interface for the `sort` package that returns
+// the total length of the slice
+func (bySemver BySemver) Len() int {
+	return len(bySemver)
+}
+
+// Swap implements the required interface for the `sort` package that swaps
+// two members of a slice
+func (bySemver BySemver) Swap(i, j int) {
+	bySemver[i], bySemver[j] = bySemver[j], bySemver[i]
+}
+
+// Less implements the required interface for the `sort` package that returns
+// true if the element at `i` is less than the element at `j`
+func (bySemver BySemver) Less(i, j int) bool {
+	return Compare(bySemver[i], bySemver[j]) < 0
+}
+
+// Compare returns -1 if :a is lower than :b, 1 if :a is higher than :b,
+// and 0 if both are of equal precedence as defined by section 11 of the
+// SemVer 2.0.0 specification. Build metadata is ignored
+func Compare(a ISemver, b ISemver) int {
+	if result := compareInt(a.GetMajorInt(), b.GetMajorInt()); result != 0 {
+		return result
+	} else if result := compareInt(a.GetMinorInt(), b.GetMinorInt()); result != 0 {
+		return result
+	} else if result := compareInt(a.GetPatchInt(), b.GetPatchInt()); result != 0 {
+		return result
+	}
+	return comparePrerelease(a.GetLabel(), b.GetLabel())
+}
+
+// Diff returns the most significant section which differs between the
+// versions :from and :to, one of "major", "minor", "patch", "premajor",
+// "preminor", "prepatch", "prerelease", "build", or "" if they are the
+// same. Changes to the major, minor or patch version are prefixed with
+// "pre" if the higher of the versions is a prerelease, while changes to
+// only the label, such as releasing 1.0.0-rc.1 as 1.0.0, are "prerelease"
+func Diff(from ISemver, to ISemver) string {
+	higher := to
+	if Compare(from, to) > 0 {
+		higher = from
+	}
+	pre := ""
+	if len(higher.GetLabel()) > 0 {
+		pre = "pre"
+	}
+	switch {
+	case from.GetMajorInt() != to.GetMajorInt():
+		return pre + "major"
+	case from.GetMinorInt() != to.GetMinorInt():
+		return pre + "minor"
+	case from.GetPatchInt() != to.GetPatchInt():
+		return pre + "patch"
+	case from.GetLabel() != to.GetLabel():
+		return "prerelease"
+	case from.GetBuild() != to.GetBuild():
+		return "build"
+	}
+	return ""
+}
+
+// comparePrerelease compares the pre-release sections :a and :b identifier
+// by identifier. A version without a pre-release has a higher precedence
+// than one with a pre-release, and a larger set of identifiers has a higher
+// precedence than a smaller set if all preceding identifiers are equal
+func comparePrerelease(a string, b string) int {
+	if a == b {
+		return 0
+	} else if len(a) == 0 {
+		return 1
+	} else if len(b) == 0 {
+		return -1
+	}
+	aIdentifiers := strings.Split(a, ".")
+	bIdentifiers := strings.Split(b, ".")
+	for i := 0; i < len(aIdentifiers) && i < len(bIdentifiers); i++ {
+		if result := compareIdentifier(aIdentifiers[i], bIdentifiers[i]); result != 0 {
+			return result
+		}
+	}
+	return compareInt(len(aIdentifiers), len(bIdentifiers))
+}
+
+// compareIdentifier compares two pre-release identifiers. Numeric identifiers
+// are compared numerically and always have a lower precedence than
+// alphanumeric identifiers, which are compared lexically in ASCII order
+func compareIdentifier(a string, b string) int {
+	aIsNumeric := IsNumericIdentifier(a)
+	bIsNumeric := IsNumericIdentifier(b)
+	switch {
+	case aIsNumeric && bIsNumeric:
+		a = strings.TrimLeft(a, "0")
+		b = strings.TrimLeft(b, "0")
+		if result := compareInt(len(a), len(b)); result != 0 {
+			return result
+		}
+		return strings.Compare(a, b)
+	case aIsNumeric:
+		return -1
+	case bIsNumeric:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// compareInt returns -1, 0 or 1 depending on whether :a is lower than, equal
+// to or higher than :b
+func compareInt(a int, b int) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
@@ -1,4 +1,4 @@
-package main
+package semver
 
 import (
 	"sort"
@@ -26,8 +26,8 @@ func (s *SemverUtilsTestSuite) TestNew() {
 }
 
 func (s *SemverUtilsTestSuite) TestNewFrom() {
-	semver, err := NewFrom(func() (int, int, int, string, string, error) {
-		return 5, 6, 7, "newFrom.8", "", nil
+	semver, err := NewFrom(func() (int, int, int, string, string, string, error) {
+		return 5, 6, 7, "newFrom.8", "", "", nil
 	})
 	if err != nil {
 		panic(err)
@@ -54,9 +54,9 @@ func (s *SemverUtilsTestSuite) TestSort() {
 	assert.Equal(s.T(), []ISemver{
 		New(1, 0, 0, "alpha.1"),
 		New(1, 0, 0, "beta"),
+		New(1, 0, 0, "rc"),
 		New(1, 0, 0, "rc.1"),
 		New(1, 0, 0, "rc.2"),
-		New(1, 0, 0, "rc"),
 		New(1, 1, 1, "beta.1"),
 		New(1, 1, 1, ""),
 		New(2, 10, 0, ""),
@@ -145,13 +145,13 @@ func (s *SemverUtilsTestSuite) TestBySemver_labelIntStringMix() {
 	}
 	sort.Stable(BySemver(semvers))
 	assert.Equal(s.T(), []ISemver{
-		New(0, 0, 0, "alpha.43"),
 		New(0, 0, 0, "alpha"),
-		New(0, 0, 0, "beta.44"),
+		New(0, 0, 0, "alpha.43"),
 		New(0, 0, 0, "beta"),
+		New(0, 0, 0, "beta.44"),
+		New(0, 0, 0, "rc"),
 		New(0, 0, 0, "rc.1"),
 		New(0, 0, 0, "rc.42"),
-		New(0, 0, 0, "rc"),
 	}, semvers)
 }
 
@@ -165,10 +165,10 @@ func (s *SemverUtilsTestSuite) TestBySemver_labelIntStringMix_multiSegment() {
 	}
 	sort.Stable(BySemver(semvers))
 	assert.Equal(s.T(), []ISemver{
-		New(0, 0, 0, "a.b.c.d"),
-		New(0, 0, 0, "a.b.c"),
-		New(0, 0, 0, "a.b"),
 		New(0, 0, 0, "a"),
+		New(0, 0, 0, "a.b"),
+		New(0, 0, 0, "a.b.c"),
+		New(0, 0, 0, "a.b.c.d"),
 		New(0, 0, 0, ""),
 	}, semvers)
 }
@@ -196,3 +196,97 @@ func (s *SemverUtilsTestSuite) TestBySemver_withDuplicates() {
 		New(10, 0, 1, ""),
 	}, semvers)
 }
+
+func (s *SemverUtilsTestSuite) TestCompare() {
+	testCases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		// precedence examples from section 11 of the specification
+		{"1.0.0", "2.0.0", -1},
+		{"2.0.0", "2.1.0", -1},
+		{"2.1.0", "2.1.1", -1},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"1.0.0-beta", "1.0.0-beta.2", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-beta.11", "1.0.0-rc.1", -1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		// reversed and equal cases
+		{"2.0.0", "1.0.0", 1},
+		{"1.0.0-beta", "1.0.0-alpha.beta", 1},
+		{"1.0.0", "1.0.0", 0},
+		{"1.0.0-rc.1", "1.0.0-rc.1", 0},
+		// numeric identifiers have lower precedence than alphanumeric ones
+		{"1.0.0-1", "1.0.0-a", -1},
+		{"1.0.0-rc.9", "1.0.0-rc.a", -1},
+		{"1.0.0-rc.99999999999999999999", "1.0.0-rc.100000000000000000000", -1},
+		// alphanumeric identifiers are compared in ascii order
+		{"1.0.0-RC", "1.0.0-rc", -1},
+		{"1.0.0-rc-1", "1.0.0-rc.1", 1},
+		// build metadata is ignored
+		{"1.0.0+build.1", "1.0.0+build.2", 0},
+		{"1.0.0-rc.1+sha.b", "1.0.0-rc.1+sha.a", 0},
+		{"1.0.0+build", "1.0.1", -1},
+	}
+	for _, testCase := range testCases {
+		assert.Equal(
+			s.T(),
+			testCase.expected,
+			Compare(MustParse(testCase.a), MustParse(testCase.b)),
+			"%s <=> %s", testCase.a, testCase.b,
+		)
+	}
+}
+
+func (s *SemverUtilsTestSuite) TestSort_specificationOrder() {
+	expected := []ISemver{
+		MustParse("1.0.0-alpha"),
+		MustParse("1.0.0-alpha.1"),
+		MustParse("1.0.0-alpha.beta"),
+		MustParse("1.0.0-beta"),
+		MustParse("1.0.0-beta.2"),
+		MustParse("1.0.0-beta.11"),
+		MustParse("1.0.0-rc.1"),
+		MustParse("1.0.0"),
+	}
+	semvers := []ISemver{
+		expected[6], expected[3], expected[7], expected[0],
+		expected[5], expected[2], expected[4], expected[1],
+	}
+	assert.Equal(s.T(), expected, Sort(semvers))
+}
+
+func (s *SemverUtilsTestSuite) TestDiff() {
+	testCases := []struct {
+		from     string
+		to       string
+		expected string
+	}{
+		{"1.2.3", "2.0.0", "major"},
+		{"1.2.3", "1.3.0", "minor"},
+		{"1.2.3", "1.2.4", "patch"},
+		{"1.2.3", "2.0.0-rc.0", "premajor"},
+		{"1.2.3", "1.3.0-beta.0", "preminor"},
+		{"1.2.3", "1.2.4-alpha.0", "prepatch"},
+		{"2.0.0-rc.0", "2.0.0-rc.1", "prerelease"},
+		{"2.0.0-rc.1", "2.0.0", "prerelease"},
+		{"1.2.3+build.1", "1.2.3+build.2", "build"},
+		{"1.2.3", "1.2.3", ""},
+		// downgrades are described by the higher version
+		{"2.0.0", "1.2.3", "major"},
+		{"2.0.0-rc.0", "1.2.3", "premajor"},
+		{"1.3.0", "1.2.4-rc.0", "minor"},
+	}
+	for _, testCase := range testCases {
+		assert.Equal(
+			s.T(),
+			testCase.expected,
+			Diff(MustParse(testCase.from), MustParse(testCase.to)),
+			"%s -> %s", testCase.from, testCase.to,
+		)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
+)
+
+// VersionSource defines a place where the version of a project is kept
+type VersionSource interface {
+	// Load returns a SemverLoader for the version selected by :mode
+	Load(mode string, prefix ...string) semver.SemverLoader
+	// List retrieves all versions available from the source
+	List(prefix ...string) ([]semver.ISemver, error)
+	// Write records the :version as the version of the project, with the
+	// :change describing how the version came about
+	Write(version semver.ISemver, change versionChange) error
+}
+
+// sourceOptions defines how a version source should be created
+type sourceOptions struct {
+	// path is the path to the file holding the version for file-based
+	// sources, each source has its own default when this is not specified
+	path string
+	// tagging defines how tags should be created for the git source
+	tagging tagOptions
+}
+
+// versionSourceProvider creates a VersionSource from the :options
+type versionSourceProvider func(options sourceOptions) VersionSource
+
+// versionSources holds the providers of all sources selectable via --use
+var versionSources = map[string]versionSourceProvider{}
+
+// registerVersionSource makes the source provided by :provider available
+// under the :name
+func registerVersionSource(name string, provider versionSourceProvider) {
+	versionSources[name] = provider
+}
+
+// versionSourceNames returns the names of all registered sources
+func versionSourceNames() []string {
+	var names []string
+	for name := range versionSources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newVersionSource creates the version source registered under :name
+func newVersionSource(name string, options sourceOptions) (VersionSource, error) {
+	provider, ok := versionSources[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("invalid engine '%s' specified, expected one of %s", name, strings.Join(versionSourceNames(), ", "))
+	}
+	return provider(options), nil
+}
+
+// getVersionSource creates the version source selected by the flags of a
+// command
+func getVersionSource(c *cli.Context) (VersionSource, error) {
+	return newVersionSource(c.String("use"), sourceOptions{
+		path:    c.String("use-path"),
+		tagging: getTagOptions(c),
+	})
+}
+
+// loadOr returns a SemverLoader which loads the version selected by :mode
+// from the :source, or the :initial version if the source has no versions
+// at all
+func loadOr(source VersionSource, initial semver.ISemver, mode string, prefix ...string) semver.SemverLoader {
+	load := source.Load(mode, prefix...)
+	return func() (int, int, int, string, string, string, error) {
+		major, minor, patch, label, build, versionPrefix, err := load()
+		if errorCause(err) != ErrNoTags {
+			return major, minor, patch, label, build, versionPrefix, err
+		}
+		if versions, listErr := source.List(prefix...); listErr != nil || len(versions) > 0 {
+			return major, minor, patch, label, build, versionPrefix, err
+		}
+		return toSemverLoader(initial)()
+	}
+}
+
+// toSemverLoader returns a SemverLoader which loads the :version
+func toSemverLoader(version semver.ISemver) semver.SemverLoader {
+	return func() (int, int, int, string, string, string, error) {
+		return version.GetMajorInt(),
+			version.GetMinorInt(),
+			version.GetPatchInt(),
+			version.GetLabel(),
+			version.GetBuild(),
+			version.GetPrefix(),
+			nil
+	}
+}
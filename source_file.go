@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/zephinzer/semver/semver"
+)
+
+func init() {
+	registerFileSource("file", "VERSION", "%s\n", regexLocator(regexp.MustCompile(`^\s*(\S+)`)))
+	registerFileSource("npm", "package.json", "", jsonKeyLocator("version"))
+	registerFileSource("cargo", "Cargo.toml", "", tomlKeyLocator("package", "version"))
+	registerFileSource("pyproject", "pyproject.toml", "", tomlKeyLocator("project", "version"), tomlKeyLocator("tool", "poetry", "version"))
+	registerFileSource("helm", "Chart.yaml", "", yamlKeyLocator("version"))
+	registerFileSource("go", "version.go", "", regexLocator(regexp.MustCompile(`\bVersion\s*=\s*"([^"]*)"`)))
+}
+
+// fileSourceLocators holds the locators of the file-based sources by the
+// name of the file they read from by default
+var fileSourceLocators = map[string][]versionLocator{}
+
+// registerFileSource registers a file-based version source under :name
+// which reads the file at :defaultPath unless another path is specified
+func registerFileSource(name string, defaultPath string, template string, locators ...versionLocator) {
+	fileSourceLocators[defaultPath] = locators
+	registerVersionSource(name, func(options sourceOptions) VersionSource {
+		path := options.path
+		if len(path) == 0 {
+			path = defaultPath
+		}
+		return &FileLoader{
+			path:     path,
+			locators: locators,
+			template: template,
+		}
+	})
+}
+
+// FileLoader loads the version from a file in the project such as a
+// package.json or a Cargo.toml
+type FileLoader struct {
+	// path is the path to the file holding the version
+	path string
+	// locators find the version in the file, in order of preference
+	locators []versionLocator
+	// template is used to create the file if it does not exist, files
+	// are never created if this is empty
+	template string
+}
+
+// Load returns a SemverLoader for the version in the file. files only hold
+// a single version so the :mode is ignored
+func (fileLoader *FileLoader) Load(mode string, prefix ...string) semver.SemverLoader {
+	return func() (int, int, int, string, string, string, error) {
+		contents, start, end, err := fileLoader.find()
+		if err != nil {
+			return 0, 0, 0, "", "", "", err
+		}
+		version, err := parseVersionArgument(contents[start:end], strings.Join(prefix, ""))
+		if err != nil {
+			return 0, 0, 0, "", "", "", fmt.Errorf("invalid version in %s: %s", fileLoader.path, err)
+		}
+		return toSemverLoader(version)()
+	}
+}
+
+// List retrieves the version in the file, or no versions if the file does
+// not hold one
+func (fileLoader *FileLoader) List(prefix ...string) ([]semver.ISemver, error) {
+	version, err := semver.NewFrom(fileLoader.Load("", prefix...))
+	if errorCause(err) == ErrNoTags {
+		return []semver.ISemver{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return []semver.ISemver{version}, nil
+}
+
+// Write replaces the version in the file with the :version. the prefix is
+// only written if the version being replaced had one
+func (fileLoader *FileLoader) Write(version semver.ISemver, change versionChange) error {
+	fileUpdate, err := fileLoader.update(version)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fileUpdate.path, []byte(fileUpdate.after), 0644)
+}
+
+// update returns the change replacing the version in the file with the
+// :version, creating the file from the template if it does not exist
+func (fileLoader *FileLoader) update(version semver.ISemver) (fileChange, error) {
+	contents, start, end, err := fileLoader.find()
+	if errorCause(err) == ErrNoTags && len(contents) == 0 && len(fileLoader.template) > 0 {
+		return fileChange{fileLoader.path, "", fmt.Sprintf(fileLoader.template, version.String())}, nil
+	} else if err != nil {
+		return fileChange{}, err
+	}
+	return fileChange{fileLoader.path, contents, replaceVersions(contents, [][]int{{start, end}}, version)}, nil
+}
+
+// find returns the contents of the file along with the start and end
+// offsets of the version within them
+func (fileLoader *FileLoader) find() (string, int, int, error) {
+	notFound := &causedError{ErrNoTags, fmt.Sprintf("no version found in %s", fileLoader.path)}
+	data, err := ioutil.ReadFile(fileLoader.path)
+	if os.IsNotExist(err) {
+		return "", 0, 0, notFound
+	} else if err != nil {
+		return "", 0, 0, err
+	}
+	contents := string(data)
+	for _, locator := range fileLoader.locators {
+		if locations := locator(contents); len(locations) > 0 {
+			return contents, locations[0][0], locations[0][1], nil
+		}
+	}
+	return contents, 0, 0, notFound
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/zephinzer/semver/semver"
+)
+
+type FileLoaderTestSuite struct {
+	suite.Suite
+	directory string
+}
+
+func TestFileLoader(t *testing.T) {
+	suite.Run(t, new(FileLoaderTestSuite))
+}
+
+func (s *FileLoaderTestSuite) SetupTest() {
+	var err error
+	if s.directory, err = ioutil.TempDir("", "gosemver-test-"); err != nil {
+		panic(err)
+	}
+}
+
+func (s *FileLoaderTestSuite) TearDownTest() {
+	os.RemoveAll(s.directory)
+}
+
+// source creates the source registered as :name reading from a file with
+// the :contents
+func (s *FileLoaderTestSuite) source(name string, contents string) (VersionSource, string) {
+	path := filepath.Join(s.directory, name)
+	if len(contents) > 0 {
+		if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+			panic(err)
+		}
+	}
+	source, err := newVersionSource(name, sourceOptions{path: path})
+	if err != nil {
+		panic(err)
+	}
+	return source, path
+}
+
+func (s *FileLoaderTestSuite) read(path string) string {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+	return string(contents)
+}
+
+func (s *FileLoaderTestSuite) Test_sources() {
+	sources := map[string]string{
+		"file":      "1.2.3\n",
+		"npm":       "{\n  \"name\": \"app\",\n  \"version\": \"1.2.3\"\n}\n",
+		"cargo":     "[dependencies]\nserde = { version = \"9.9.9\" }\n\n[package]\nname = \"app\"\nversion = \"1.2.3\"\n",
+		"pyproject": "[tool.black]\nversion = \"9.9.9\"\n\n[tool.poetry]\nname = \"app\"\nversion = \"1.2.3\"\n",
+		"helm":      "apiVersion: v2\nname: app\nversion: 1.2.3\nappVersion: \"9.9.9\"\n",
+		"go":        "package main\n\n// Version of the app\nconst Version = \"1.2.3\"\n",
+	}
+	for name, contents := range sources {
+		source, path := s.source(name, contents)
+		version, err := semver.NewFrom(source.Load("latest"))
+		assert.Nil(s.T(), err, name)
+		assert.Equal(s.T(), "1.2.3", version.String(), name)
+		version.BumpMinor()
+		assert.Nil(s.T(), source.Write(version, versionChange{}), name)
+		assert.Equal(s.T(), strings.Replace(contents, "1.2.3", "1.3.0", 1), s.read(path), name)
+	}
+}
+
+func (s *FileLoaderTestSuite) Test_Load_missing() {
+	source, path := s.source("npm", "{}\n")
+	_, err := semver.NewFrom(source.Load("latest"))
+	assert.EqualError(s.T(), err, "no version found in "+path)
+	assert.Equal(s.T(), ErrNoTags, errorCause(err))
+	versions, err := source.List()
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), versions, 0)
+}
+
+func (s *FileLoaderTestSuite) Test_Write_prefix() {
+	source, path := s.source("file", "v1.2.3\n")
+	version, err := semver.NewFrom(source.Load("latest", "v"))
+	assert.Nil(s.T(), err)
+	version.BumpPatch()
+	assert.Nil(s.T(), source.Write(version, versionChange{}))
+	assert.Equal(s.T(), "v1.2.4\n", s.read(path))
+	source, path = s.source("npm", "{\"version\": \"1.2.3\"}")
+	assert.Nil(s.T(), source.Write(semver.MustParse("v2.0.0", "v"), versionChange{}))
+	assert.Equal(s.T(), "{\"version\": \"2.0.0\"}", s.read(path))
+}
+
+func (s *FileLoaderTestSuite) Test_Write_create() {
+	source, path := s.source("file", "")
+	assert.Nil(s.T(), source.Write(semver.MustParse("0.1.0"), versionChange{}))
+	assert.Equal(s.T(), "0.1.0\n", s.read(path))
+	source, _ = s.source("npm", "")
+	assert.NotNil(s.T(), source.Write(semver.MustParse("0.1.0"), versionChange{}))
+}
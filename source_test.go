@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/zephinzer/semver/semver"
+)
+
+type SourceTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestSource(t *testing.T) {
+	suite.Run(t, new(SourceTestSuite))
+}
+
+func (s *SourceTestSuite) Test_newVersionSource() {
+	source, err := newVersionSource("GIT", sourceOptions{tagging: tagOptions{annotate: true}})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), &GitLoader{tagging: tagOptions{annotate: true}}, source)
+	source, err = newVersionSource("npm", sourceOptions{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "package.json", source.(*FileLoader).path)
+	source, err = newVersionSource("npm", sourceOptions{path: "web/package.json"})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "web/package.json", source.(*FileLoader).path)
+}
+
+func (s *SourceTestSuite) Test_newVersionSource_invalid() {
+	_, err := newVersionSource("svn", sourceOptions{})
+	assert.EqualError(s.T(), err, "invalid engine 'svn' specified, expected one of cargo, file, git, go, helm, npm, pyproject")
+}
+
+func (s *SourceTestSuite) Test_loadOr() {
+	s.commit("initial commit")
+	initial := semver.MustParse("0.1.0")
+	version, err := semver.NewFrom(loadOr(&GitLoader{}, initial, "latest"))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "0.1.0", version.String())
+	s.git("tag", "1.0.0")
+	version, err = semver.NewFrom(loadOr(&GitLoader{}, initial, "latest"))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "1.0.0", version.String())
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/urfave/cli"
+)
+
+// defaultTagMessage is the message template used for annotated tags when
+// no message template is specified
+const defaultTagMessage = "Release {{.Next}}"
+
+// tagOptions defines how a version tag should be created
+type tagOptions struct {
+	annotate bool
+	message  string
+	sign     bool
+	signKey  string
+	push     string
+	rollback bool
+}
+
+// versionChange describes a change in version and is the data made
+// available to the tag message template
+type versionChange struct {
+	// BumpType is the type of change which led to the new version, one of
+	// bumpTypes or 'set'
+	BumpType string
+	// Previous is the version before the change
+	Previous string
+	// Next is the version being tagged
+	Next string
+}
+
+// getTagOptions retrieves the tag options from the flags of a command
+func getTagOptions(c *cli.Context) tagOptions {
+	return tagOptions{
+		annotate: c.Bool("annotate"),
+		message:  c.String("message"),
+		sign:     c.Bool("sign"),
+		signKey:  c.String("sign-key"),
+		push:     c.String("push"),
+		rollback: c.Bool("rollback"),
+	}
+}
+
+// isAnnotated returns true if the options call for a tag object rather than
+// a lightweight tag. specifying a message or signing implies annotation
+func (options tagOptions) isAnnotated() bool {
+	return options.annotate || options.isSigned() || len(options.message) > 0
+}
+
+// isSigned returns true if the options call for a signed tag
+func (options tagOptions) isSigned() bool {
+	return options.sign || len(options.signKey) > 0
+}
+
+// renderMessage renders the tag message template using the :change
+func (options tagOptions) renderMessage(change versionChange) (string, error) {
+	messageTemplate := options.message
+	if len(messageTemplate) == 0 {
+		messageTemplate = defaultTagMessage
+	}
+	parsedTemplate, err := template.New("message").Parse(messageTemplate)
+	if err != nil {
+		return "", err
+	}
+	var message bytes.Buffer
+	if err := parsedTemplate.Execute(&message, change); err != nil {
+		return "", err
+	}
+	return message.String(), nil
+}
+
+// createTag creates the :tag using the provided :options, rendering the tag
+// message with the :change if the tag is to be annotated, and pushing it if
+// a remote was specified
+func createTag(tag string, options tagOptions, change versionChange) error {
+	message := ""
+	if options.isAnnotated() {
+		var err error
+		if message, err = options.renderMessage(change); err != nil {
+			return err
+		}
+	}
+	if _, err := gitTag(tag, gitTagArguments(message, options)...); err != nil {
+		return err
+	}
+	return pushTag(tag, options)
+}
+
+// pushTag pushes the :tag to the remote specified in the :options if any,
+// deleting the local :tag if the push fails and a rollback was requested
+func pushTag(tag string, options tagOptions) error {
+	if len(options.push) == 0 {
+		return nil
+	}
+	if _, err := gitPushTag(options.push, tag); err != nil {
+		if options.rollback {
+			if _, rollbackErr := gitTagDelete(tag); rollbackErr != nil {
+				return fmt.Errorf("%s (rollback also failed: %s)", err, rollbackErr)
+			}
+			return fmt.Errorf("%s (local tag '%s' has been rolled back)", err, tag)
+		}
+		return err
+	}
+	return nil
+}
+
+// gitTagArguments returns the `git tag` flags for the provided :options and
+// rendered :message
+func gitTagArguments(message string, options tagOptions) []string {
+	var arguments []string
+	if len(options.signKey) > 0 {
+		arguments = append(arguments, "--local-user", options.signKey)
+	} else if options.sign {
+		arguments = append(arguments, "--sign")
+	} else if options.isAnnotated() {
+		arguments = append(arguments, "--annotate")
+	}
+	if options.isAnnotated() {
+		arguments = append(arguments, "--message", message)
+	}
+	return arguments
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TagTestSuite struct {
+	GitRepositoryTestSuite
+}
+
+func TestTag(t *testing.T) {
+	suite.Run(t, new(TagTestSuite))
+}
+
+func (s *TagTestSuite) Test_renderMessage_default() {
+	message, err := tagOptions{annotate: true}.renderMessage(versionChange{"minor", "1.0.0", "1.1.0"})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "Release 1.1.0", message)
+}
+
+func (s *TagTestSuite) Test_renderMessage_template() {
+	options := tagOptions{message: "{{.BumpType}} bump: {{.Previous}} -> {{.Next}}"}
+	message, err := options.renderMessage(versionChange{"minor", "1.0.0", "1.1.0"})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "minor bump: 1.0.0 -> 1.1.0", message)
+}
+
+func (s *TagTestSuite) Test_renderMessage_invalidTemplate() {
+	_, err := tagOptions{message: "{{.Next"}.renderMessage(versionChange{})
+	assert.NotNil(s.T(), err)
+	_, err = tagOptions{message: "{{.Unknown}}"}.renderMessage(versionChange{})
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TagTestSuite) Test_gitTagArguments() {
+	assert.Empty(s.T(), gitTagArguments("", tagOptions{}))
+	assert.Equal(s.T(), []string{"--annotate", "--message", "msg"}, gitTagArguments("msg", tagOptions{annotate: true}))
+	assert.Equal(s.T(), []string{"--annotate", "--message", "msg"}, gitTagArguments("msg", tagOptions{message: "{{.Next}}"}))
+	assert.Equal(s.T(), []string{"--sign", "--message", "msg"}, gitTagArguments("msg", tagOptions{sign: true}))
+	assert.Equal(s.T(), []string{"--local-user", "ABCD1234", "--message", "msg"}, gitTagArguments("msg", tagOptions{signKey: "ABCD1234"}))
+}
+
+func (s *TagTestSuite) Test_createTag_lightweight() {
+	s.commit("initial commit")
+	assert.Nil(s.T(), createTag("1.0.0", tagOptions{}, versionChange{"set", "nothing", "1.0.0"}))
+	assert.Equal(s.T(), "commit", s.git("cat-file", "-t", "1.0.0"))
+}
+
+func (s *TagTestSuite) Test_createTag_annotated() {
+	s.commit("initial commit")
+	options := tagOptions{message: "{{.BumpType}} from {{.Previous}}"}
+	assert.Nil(s.T(), createTag("1.1.0", options, versionChange{"minor", "1.0.0", "1.1.0"}))
+	assert.Equal(s.T(), "tag", s.git("cat-file", "-t", "1.1.0"))
+	assert.Equal(s.T(), "minor from 1.0.0", s.git("tag", "--list", "--format=%(contents:subject)", "1.1.0"))
+}
+
+func (s *TagTestSuite) Test_createTag_push() {
+	s.commit("initial commit")
+	s.git("tag", "0.9.0")
+	s.addRemote("origin")
+	assert.Nil(s.T(), createTag("1.0.0", tagOptions{push: "origin"}, versionChange{}))
+	remoteTags := s.git("ls-remote", "--tags", "origin")
+	assert.Contains(s.T(), remoteTags, "refs/tags/1.0.0")
+	assert.NotContains(s.T(), remoteTags, "refs/tags/0.9.0")
+}
+
+func (s *TagTestSuite) Test_createTag_pushRejected() {
+	s.commit("initial commit")
+	s.addRemote("origin")
+	s.git("tag", "1.0.0")
+	s.git("push", "origin", "refs/tags/1.0.0")
+	s.git("tag", "--delete", "1.0.0")
+	s.commit("concurrent commit")
+	err := createTag("1.0.0", tagOptions{push: "origin"}, versionChange{})
+	assert.Contains(s.T(), err.Error(), "failed to push tag '1.0.0' to 'origin'")
+	assert.Equal(s.T(), "1.0.0", s.git("tag", "--points-at", "HEAD"))
+}
+
+func (s *TagTestSuite) Test_createTag_pushRejectedWithRollback() {
+	s.commit("initial commit")
+	s.addRemote("origin")
+	s.git("tag", "1.0.0")
+	s.git("push", "origin", "refs/tags/1.0.0")
+	s.git("tag", "--delete", "1.0.0")
+	s.commit("concurrent commit")
+	err := createTag("1.0.0", tagOptions{push: "origin", rollback: true}, versionChange{})
+	assert.Contains(s.T(), err.Error(), "local tag '1.0.0' has been rolled back")
+	assert.Empty(s.T(), s.git("tag", "--list"))
+}
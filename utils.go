@@ -3,11 +3,10 @@ package main
 import (
 	"bufio"
 	"fmt"
-	"regexp"
-	"strconv"
 	"strings"
 
 	"github.com/urfave/cli"
+	"github.com/zephinzer/semver/semver"
 )
 
 // commandProvider defines a function that returns a command handler
@@ -22,15 +21,6 @@ const confirmationFalseCanonical = "n"
 // confirmationFalseCanonical defines the canonical acceptance character
 const confirmationTrueCanonical = "y"
 
-// semverIntSection for use in regexp building
-const semverIntSection = `(0|[1-9]{1}[\d]*){1}`
-
-// semverIntSeperator for use in regexp building
-const semverIntSeperator = `\.`
-
-// semverLabelSection for use in regexp building
-const semverLabelSection = `(\-[a-zA-Z0-9\.\_]*)*`
-
 // confirmationFalse defines the alises of a rejection
 var confirmationFalse = []string{confirmationFalseCanonical, "no", "nope", "nah", "neh", "stop", "dont"}
 
@@ -92,36 +82,17 @@ func flags(flags ...flagProvider) []cli.Flag {
 func filterSemverLike(versionList []string, prefix ...string) []string {
 	var semverVersionList []string
 	for _, version := range versionList {
-		if isSemverLike(version, prefix...) {
+		if semver.IsSemverLike(version, prefix...) {
 			semverVersionList = append(semverVersionList, version)
 		}
 	}
 	return semverVersionList
 }
 
-// isSemverLike returns true if the provided :version follows a semver
-// pattern, and false otherwise
-func isSemverLike(version string, prefix ...string) bool {
-	prefixString := ""
-	if len(prefix) > 0 {
-		prefixString = prefixString + prefix[0]
-	}
-	regexpString :=
-		"^" + // start
-			"(" + prefixString + `)` + // for prefix strings
-			semverIntSection + // for major version
-			semverIntSeperator + // .
-			semverIntSection + // for minor version
-			semverIntSeperator + // .
-			semverIntSection + // for patch versoin
-			semverLabelSection + // for label string (-<LABEL>)
-			"$" // end
-	if matched, err := regexp.Match(regexpString, []byte(version)); err != nil {
-		panic(err)
-	} else if matched {
-		return true
-	}
-	return false
+// parseVersionArgument parses a version provided by the user, which may or
+// may not include the :prefix
+func parseVersionArgument(version string, prefix string) (*semver.Semver, error) {
+	return semver.Parse(prefix+strings.TrimPrefix(version, prefix), prefix)
 }
 
 func removeEmptyStringsFromStringSlice(slice []string) []string {
@@ -134,6 +105,11 @@ func removeEmptyStringsFromStringSlice(slice []string) []string {
 	return finalSlice
 }
 
+// splitLines splits :value into its non-empty lines
+func splitLines(value string) []string {
+	return removeEmptyStringsFromStringSlice(strings.Split(value, "\n"))
+}
+
 // sliceContainsString returns true if the :slice contains the :search
 // string
 func sliceContainsString(slice []string, search string) bool {
@@ -145,28 +121,6 @@ func sliceContainsString(slice []string, search string) bool {
 	return false
 }
 
-// toSemver converts the string :from into the Semver struct
-func toSemver(from string, prefix ...string) ISemver {
-	major := 0
-	minor := 0
-	patch := 0
-	label := ""
-	semverSections := strings.Split(from, "-")
-	versionPrefix := ""
-	if len(prefix) > 0 {
-		versionPrefix = prefix[0]
-	}
-	semver := strings.Trim(semverSections[0], versionPrefix)
-	semverNumbers := strings.Split(semver, ".")
-	major, _ = strconv.Atoi(semverNumbers[0])
-	minor, _ = strconv.Atoi(semverNumbers[1])
-	patch, _ = strconv.Atoi(semverNumbers[2])
-	if len(semverSections) > 1 {
-		label = strings.Join(semverSections[1:], "-")
-	}
-	return &Semver{major, minor, patch, label, versionPrefix}
-}
-
 // trimAndNormalise is for making sure we're Windows compatible
 func trimAndNormalise(value string) string {
 	return strings.Trim(
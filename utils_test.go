@@ -125,73 +125,6 @@ func (s *UtilsTestSuite) Test_filterSemverLikeWithMutliCharFilter() {
 	)
 }
 
-func (s *UtilsTestSuite) Test_isSemverLike_basicChecks_isSemver() {
-	isSemvers := []string{
-		"0.0.0",
-		"1.0.0",
-		"10.0.0",
-		"0.0.0",
-		"0.1.0",
-		"0.10.0",
-		"0.0.0",
-		"0.0.1",
-		"0.0.10",
-		"0.0.0-alpha",
-		"0.0.0-alpha.1",
-		"0.0.0-beta",
-		"0.0.0-beta.1",
-		"0.0.0-rc",
-		"0.0.0-rc.42",
-	}
-	for _, isSemver := range isSemvers {
-		assert.True(s.T(), isSemverLike(isSemver))
-	}
-}
-
-func (s *UtilsTestSuite) Test_isSemverLike_basicChecks_notSemver() {
-	notSemvers := []string{
-		"00.0.0",
-		"0.00.0",
-		"0.0.00",
-		"a.0.0",
-		"1.b.0",
-		"10.0.c",
-		"a.0.0",
-		"0.1.b",
-		"0.10.c",
-		"!.0.0",
-		"0.X.1",
-		"0.*.10",
-	}
-	for _, notSemver := range notSemvers {
-		assert.False(s.T(), isSemverLike(notSemver))
-	}
-}
-
-func (s *UtilsTestSuite) Test_isSemverLike_basicChecks_isSemverWithLabel() {
-	isSemvers := []string{
-		"0.0.0-label",
-		"0.0.0-labelWithCaps",
-		"0.0.0-label.with.dots",
-		"0.0.0-label-with-dashes",
-		"0.0.0-label_with_underscores",
-		"0.0.0-label.with-all_possible-delims",
-	}
-	for _, isSemver := range isSemvers {
-		assert.True(s.T(), isSemverLike(isSemver))
-	}
-}
-
-func (s *UtilsTestSuite) Test_isSemverLike_basicChecks_notSemverWithLabel() {
-	isSemvers := []string{
-		"0.0.0label",
-		"0.0.00000",
-	}
-	for _, isSemver := range isSemvers {
-		assert.False(s.T(), isSemverLike(isSemver))
-	}
-}
-
 func (s *UtilsTestSuite) Test_removeEmptyStringsFromStringSlice() {
 	testSlice := []string{"", "1", "", "2", "3", ""}
 	outputSlice := removeEmptyStringsFromStringSlice(testSlice)
@@ -209,24 +142,6 @@ func (s *UtilsTestSuite) Test_sliceContainsString() {
 	assert.False(s.T(), sliceContainsString(testSlice, "aa"))
 }
 
-func (s *UtilsTestSuite) Test_toSemver() {
-	testCases := map[string]ISemver{
-		"1.0.0":           New(1, 0, 0, ""),
-		"1.0.0-alpha.5":   New(1, 0, 0, "alpha.5"),
-		"1.1.0":           New(1, 1, 0, ""),
-		"1.1.1":           New(1, 1, 1, ""),
-		"1.1.10":          New(1, 1, 10, ""),
-		"1.10.10":         New(1, 10, 10, ""),
-		"10.10.10":        New(10, 10, 10, ""),
-		"10.10.10-beta.3": New(10, 10, 10, "beta.3"),
-		"10.10.10-beta":   New(10, 10, 10, "beta"),
-		"10.11.0":         New(10, 11, 0, ""),
-	}
-	for version, semver := range testCases {
-		assert.Equal(s.T(), semver, toSemver(version))
-	}
-}
-
 func (s *UtilsTestSuite) Test_trimAndNormalise() {
 	testStrings := []string{
 		"from windows\r\n",